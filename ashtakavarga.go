@@ -0,0 +1,109 @@
+// Go Swiss Ephemeris - Ashtakavarga
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+// ashtakavargaPlaces are the houses (1-12) in which each of the Sun to
+// Saturn receives a bindu, counted from the Sun, Moon, Mars, Mercury,
+// Jupiter, Venus, Saturn and the Ascendant, after Brihat Parashara Hora
+// Shastra
+var ashtakavargaPlaces = [7][8][]int{
+	{ // Sun, 48 bindus
+		{1, 2, 4, 7, 8, 9, 10, 11}, {3, 6, 10, 11}, {1, 2, 4, 7, 8, 9, 10, 11}, {3, 5, 6, 9, 10, 11, 12},
+		{5, 6, 9, 11}, {6, 7, 12}, {1, 2, 4, 7, 8, 9, 10, 11}, {3, 4, 6, 10, 11, 12},
+	},
+	{ // Moon, 49 bindus
+		{3, 6, 7, 8, 10, 11}, {1, 3, 6, 7, 10, 11}, {2, 3, 5, 6, 9, 10, 11}, {1, 3, 4, 5, 7, 8, 10, 11},
+		{1, 4, 7, 8, 10, 11, 12}, {3, 4, 5, 7, 9, 10, 11}, {3, 5, 6, 11}, {3, 6, 10, 11},
+	},
+	{ // Mars, 39 bindus
+		{3, 5, 6, 10, 11}, {3, 6, 11}, {1, 2, 4, 7, 8, 10, 11}, {3, 5, 6, 11},
+		{6, 10, 11, 12}, {6, 8, 11, 12}, {1, 4, 7, 8, 9, 10, 11}, {1, 3, 6, 10, 11},
+	},
+	{ // Mercury, 54 bindus
+		{5, 6, 9, 11, 12}, {2, 4, 6, 8, 10, 11}, {1, 2, 4, 7, 8, 9, 10, 11}, {1, 3, 5, 6, 9, 10, 11, 12},
+		{6, 8, 11, 12}, {1, 2, 3, 4, 5, 8, 9, 11}, {1, 2, 4, 7, 8, 9, 10, 11}, {1, 2, 4, 6, 8, 10, 11},
+	},
+	{ // Jupiter, 56 bindus
+		{1, 2, 3, 4, 7, 8, 9, 10, 11}, {2, 5, 7, 9, 11}, {1, 2, 4, 7, 8, 10, 11}, {1, 2, 4, 5, 6, 9, 10, 11},
+		{1, 2, 3, 4, 7, 8, 10, 11}, {2, 5, 6, 9, 10, 11}, {3, 5, 6, 12}, {1, 2, 4, 5, 6, 7, 9, 10, 11},
+	},
+	{ // Venus, 52 bindus
+		{8, 11, 12}, {1, 2, 3, 4, 5, 8, 9, 11, 12}, {3, 5, 6, 9, 11, 12}, {3, 5, 6, 9, 11},
+		{5, 8, 9, 10, 11}, {1, 2, 3, 4, 5, 8, 9, 10, 11}, {3, 4, 5, 8, 9, 10, 11}, {1, 2, 3, 4, 5, 8, 9, 11},
+	},
+	{ // Saturn, 39 bindus
+		{1, 2, 4, 7, 8, 10, 11}, {3, 6, 11}, {3, 5, 6, 10, 11, 12}, {6, 8, 9, 10, 11, 12},
+		{5, 6, 11, 12}, {6, 11, 12}, {3, 5, 6, 11}, {1, 3, 4, 6, 10, 11},
+	},
+}
+
+// Ashtakavarga holds the bindus (benefic points) of the Sun to Saturn in the
+// 12 signs
+type Ashtakavarga struct {
+	Bhinna [7][12]int // Bhinnashtakavarga of each graha, indexed by Graha and sign (0 = Aries)
+	Sarva  [12]int    // Sarvashtakavarga, the sum of the Bhinnashtakavargas; 337 bindus in all
+}
+
+// Total returns the bindus of the Bhinnashtakavarga of a graha from the Sun
+// to Saturn
+func (a Ashtakavarga) Total(g Graha) int {
+	total := 0
+	for _, n := range a.Bhinna[g] {
+		total += n
+	}
+	return total
+}
+
+// CalcAshtakavarga returns the Ashtakavarga of the sidereal longitudes of
+// the Sun to Saturn, indexed by Graha, and of the Ascendant
+func CalcAshtakavarga(lons [7]float64, asc float64) Ashtakavarga {
+	var refs [8]int
+	for i, lon := range lons {
+		refs[i] = int(normDeg(lon) / 30)
+	}
+	refs[7] = int(normDeg(asc) / 30)
+
+	var a Ashtakavarga
+	for g, places := range ashtakavargaPlaces {
+		for r, houses := range places {
+			for _, h := range houses {
+				sign := (refs[r] + h - 1) % 12
+				a.Bhinna[g][sign]++
+				a.Sarva[sign]++
+			}
+		}
+	}
+	return a
+}
+
+// ChartAshtakavarga returns the Ashtakavarga of a chart, which need not
+// contain the grahas. Use a sidereal chart built with WithSidereal.
+func ChartAshtakavarga(chart *Chart) (Ashtakavarga, error) {
+	defer chart.lockThread()()
+
+	c := &calculator{iflag: chart.Flags}
+	var lons [7]float64
+	for g := GrahaSun; g <= GrahaSaturn; g++ {
+		lon, _, err := c.grahaLon(chart.JD, g)
+		if err != nil {
+			return Ashtakavarga{}, err
+		}
+		lons[g] = lon
+	}
+	return CalcAshtakavarga(lons, chart.Angles[Asc]), c.warn
+}
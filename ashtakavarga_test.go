@@ -0,0 +1,77 @@
+// Go Swiss Ephemeris - Ashtakavarga Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import "testing"
+
+func TestCalcAshtakavarga(t *testing.T) {
+	// Every graha and the Ascendant in Aries
+	var lons [7]float64
+	for i := range lons {
+		lons[i] = 15
+	}
+	a := CalcAshtakavarga(lons, 15)
+
+	totals := [7]int{48, 49, 39, 54, 56, 52, 39}
+	for g, want := range totals {
+		if got := a.Total(Graha(g)); got != want {
+			t.Errorf("%s has %d bindus, expected %d", Graha(g), got, want)
+		}
+	}
+	// The Sun gets bindus in the 1st from the Sun, Mars and Saturn
+	if a.Bhinna[GrahaSun][0] != 3 {
+		t.Errorf("Sun has %d bindus in Aries, expected 3", a.Bhinna[GrahaSun][0])
+	}
+	// ... and in the 11th from all but Venus
+	if a.Bhinna[GrahaSun][10] != 7 {
+		t.Errorf("Sun has %d bindus in Aquarius, expected 7", a.Bhinna[GrahaSun][10])
+	}
+
+	sum := 0
+	for sign, n := range a.Sarva {
+		bhinna := 0
+		for g := range a.Bhinna {
+			bhinna += a.Bhinna[g][sign]
+		}
+		if n != bhinna {
+			t.Errorf("Sarvashtakavarga of sign %d is %d, expected %d", sign, n, bhinna)
+		}
+		sum += n
+	}
+	if sum != 337 {
+		t.Errorf("Sarvashtakavarga of %d bindus, expected 337", sum)
+	}
+}
+
+func TestChartAshtakavarga(t *testing.T) {
+	natal := testNatalChart(t, WithSidereal(SidmLahiri))
+
+	a, err := ChartAshtakavarga(natal)
+	if err != nil {
+		t.Fatalf("ChartAshtakavarga failed: %v", err)
+	}
+
+	var lons [7]float64
+	for g := range lons {
+		b, _ := natal.body(Graha(g).Body())
+		lons[g] = b.Position.Lon
+	}
+	if want := CalcAshtakavarga(lons, natal.Angles[Asc]); a != want {
+		t.Errorf("ChartAshtakavarga %+v, expected %+v", a, want)
+	}
+}
@@ -0,0 +1,266 @@
+// Go Swiss Ephemeris - Aspects
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"fmt"
+	"math"
+)
+
+// BodyClass groups points that share the same orbs
+type BodyClass int
+
+const (
+	ClassLuminary BodyClass = iota // Sun and Moon
+	ClassPersonal                  // Mercury, Venus and Mars
+	ClassSocial                    // Jupiter and Saturn
+	ClassOuter                     // Uranus, Neptune and Pluto
+	ClassAngle                     // Ascendant, MC and other house angles
+	ClassStar                      // Fixed stars
+	ClassPart                      // Arabic parts
+	ClassOther                     // Nodes, apogees, asteroids and other points
+)
+
+// BodyClassOf returns the class of a planet number
+func BodyClassOf(body int32) BodyClass {
+	switch body {
+	case Sun, Moon:
+		return ClassLuminary
+	case Mercury, Venus, Mars:
+		return ClassPersonal
+	case Jupiter, Saturn:
+		return ClassSocial
+	case Uranus, Neptune, Pluto:
+		return ClassOuter
+	}
+	return ClassOther
+}
+
+// AspectKind tells major, minor and harmonic aspects apart
+type AspectKind int
+
+const (
+	AspectMajor    AspectKind = iota // Ptolemaic aspects
+	AspectMinor                      // Semisextile, semisquare, quincunx, ...
+	AspectHarmonic                   // Aspects of a harmonic series
+)
+
+// Aspect is an aspect angle with its name and orbs
+type Aspect struct {
+	Name  string                // Name of the aspect
+	Angle float64               // Aspect angle in degrees
+	Orb   float64               // Default orb in degrees
+	Orbs  map[BodyClass]float64 // Orbs of body classes that differ from Orb
+	Kind  AspectKind            // Major, minor or harmonic
+}
+
+// OrbFor returns the orb of the aspect for a pair of points: the mean of the
+// orbs of their classes
+func (a Aspect) OrbFor(class1, class2 BodyClass) float64 {
+	return (a.classOrb(class1) + a.classOrb(class2)) / 2
+}
+
+func (a Aspect) classOrb(class BodyClass) float64 {
+	if orb, ok := a.Orbs[class]; ok {
+		return orb
+	}
+	return a.Orb
+}
+
+// DefaultAspects returns the Ptolemaic aspects with orbs of 8°, 6° for the
+// sextile. The luminaries get 2° more, fixed stars 1° and Arabic parts 2°.
+func DefaultAspects() []Aspect {
+	major := func(name string, angle, orb float64) Aspect {
+		return Aspect{
+			Name:  name,
+			Angle: angle,
+			Orb:   orb,
+			Orbs:  map[BodyClass]float64{ClassLuminary: orb + 2, ClassStar: 1, ClassPart: 2},
+			Kind:  AspectMajor,
+		}
+	}
+	return []Aspect{
+		major("Conjunction", 0, 8),
+		major("Sextile", 60, 6),
+		major("Square", 90, 8),
+		major("Trine", 120, 8),
+		major("Opposition", 180, 8),
+	}
+}
+
+// MinorAspects returns the usual minor aspects with orbs of 2°, 3° for the
+// quincunx
+func MinorAspects() []Aspect {
+	minor := func(name string, angle, orb float64) Aspect {
+		return Aspect{Name: name, Angle: angle, Orb: orb, Kind: AspectMinor}
+	}
+	return []Aspect{
+		minor("Semisextile", 30, 2),
+		minor("Semisquare", 45, 2),
+		minor("Quintile", 72, 2),
+		minor("Sesquiquadrate", 135, 2),
+		minor("Biquintile", 144, 2),
+		minor("Quincunx", 150, 3),
+	}
+}
+
+// HarmonicAspectTable returns the aspects of the n-th harmonic that are not
+// aspects of a lower harmonic, all with the same orb. They are named "k/n",
+// for example "1/7" and "2/7" for the septile and biseptile.
+func HarmonicAspectTable(n int, orb float64) []Aspect {
+	var aspects []Aspect
+	for k := 1; 2*k <= n; k++ {
+		if gcd(k, n) != 1 {
+			continue
+		}
+		aspects = append(aspects, Aspect{
+			Name:  fmt.Sprintf("%d/%d", k, n),
+			Angle: float64(k) * 360 / float64(n),
+			Orb:   orb,
+			Kind:  AspectHarmonic,
+		})
+	}
+	return aspects
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// ChartPoint is a point for CalcAspects
+type ChartPoint struct {
+	Name  string    // Name of the point
+	Class BodyClass // Class selecting the orbs
+	Lon   float64   // Ecliptic longitude
+	Speed float64   // Speed in longitude in degrees per day, 0 for fixed points
+}
+
+// NewStarPoint returns a ChartPoint for a fixed star at tjdUt
+func NewStarPoint(star string, tjdUt float64, iflag int32) (ChartPoint, error) {
+	result, err := FixstarUTE(star, tjdUt, iflag|FlagSpeed)
+	if err != nil && !IsWarning(err) {
+		return ChartPoint{}, err
+	}
+	return ChartPoint{Name: result.StarName, Class: ClassStar, Lon: result.Data[0], Speed: result.Data[3]}, err
+}
+
+// ChartAspect is an aspect between two points
+type ChartAspect struct {
+	Body1      int     // Index of the first point
+	Body2      int     // Index of the second point
+	Aspect     Aspect  // Aspect formed
+	Orb        float64 // Distance from the exact aspect in degrees
+	Separation float64 // Angular distance between the points, 0° to 180°
+	Applying   bool    // The orb is decreasing
+	Separating bool    // The orb is increasing
+	OutOfSign  bool    // The signs of the points do not match the aspect, such as a conjunction across a sign cusp
+}
+
+// CalcAspects returns the closest aspect within orb for each pair of points,
+// in point order. Whether an aspect is applying or separating follows from the
+// speeds of the points; both are false if the orb does not change.
+func CalcAspects(points []ChartPoint, aspects []Aspect) []ChartAspect {
+	var grid []ChartAspect
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			if asp, ok := pairAspect(points[i], points[j], aspects); ok {
+				asp.Body1, asp.Body2 = i, j
+				grid = append(grid, asp)
+			}
+		}
+	}
+	return grid
+}
+
+// CalcCrossAspects is CalcAspects between two sets of points, such as the
+// bodies of two charts. Body1 indexes points1 and Body2 indexes points2.
+func CalcCrossAspects(points1, points2 []ChartPoint, aspects []Aspect) []ChartAspect {
+	var grid []ChartAspect
+	for i := range points1 {
+		for j := range points2 {
+			if asp, ok := pairAspect(points1[i], points2[j], aspects); ok {
+				asp.Body1, asp.Body2 = i, j
+				grid = append(grid, asp)
+			}
+		}
+	}
+	return grid
+}
+
+// pairAspect returns the closest aspect within orb between two points
+func pairAspect(p1, p2 ChartPoint, aspects []Aspect) (ChartAspect, bool) {
+	diff := angleDiff(p1.Lon, p2.Lon)
+	sep := math.Abs(diff)
+
+	best := -1
+	bestOrb := 0.0
+	for k, a := range aspects {
+		orb := math.Abs(sep - a.Angle)
+		if orb <= a.OrbFor(p1.Class, p2.Class) && (best < 0 || orb < bestOrb) {
+			best, bestOrb = k, orb
+		}
+	}
+	if best < 0 {
+		return ChartAspect{}, false
+	}
+
+	asp := ChartAspect{
+		Aspect:     aspects[best],
+		Orb:        bestOrb,
+		Separation: sep,
+		OutOfSign:  outOfSign(p1.Lon, p2.Lon, aspects[best].Angle),
+	}
+
+	// Rate of change of the orb |sep - angle|
+	sepSpeed := (p1.Speed - p2.Speed) * sign(diff)
+	orbSpeed := sepSpeed * sign(sep-asp.Aspect.Angle)
+	if bestOrb == 0 {
+		orbSpeed = math.Abs(sepSpeed)
+	}
+	asp.Applying = orbSpeed < 0
+	asp.Separating = orbSpeed > 0
+
+	return asp, true
+}
+
+// outOfSign reports whether two longitudes form an aspect whose angle is a
+// multiple of 30° from signs that are not that many signs apart
+func outOfSign(lon1, lon2, angle float64) bool {
+	signs := math.Round(angle / 30)
+	if math.Abs(angle-signs*30) > 1e-9 {
+		return false
+	}
+	n := (int(normDeg(lon1)/30) - int(normDeg(lon2)/30) + 12) % 12
+	if n > 6 {
+		n = 12 - n
+	}
+	return n != int(signs)
+}
+
+func sign(x float64) float64 {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
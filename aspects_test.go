@@ -0,0 +1,104 @@
+// Go Swiss Ephemeris - Aspect Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalcAspects(t *testing.T) {
+	points := []ChartPoint{
+		{Name: "Sun", Class: ClassLuminary, Lon: 29, Speed: 1},
+		{Name: "Mars", Class: ClassPersonal, Lon: 31, Speed: 0.5},
+		{Name: "Saturn", Class: ClassSocial, Lon: 118, Speed: -0.05},
+		{Name: "Part", Class: ClassPart, Lon: 204},
+	}
+
+	grid := CalcAspects(points, DefaultAspects())
+	if len(grid) != 5 {
+		t.Fatalf("Expected 5 aspects, got %d: %+v", len(grid), grid)
+	}
+
+	// Sun 29° Aries conjunct Mars 1° Taurus: out of sign, and applying
+	// since the faster Sun is behind
+	conj := grid[0]
+	if conj.Body1 != 0 || conj.Body2 != 1 || conj.Aspect.Name != "Conjunction" ||
+		math.Abs(conj.Orb-2) > 1e-9 || !conj.OutOfSign || !conj.Applying || conj.Separating {
+		t.Errorf("Unexpected conjunction %+v", conj)
+	}
+
+	// Sun square Saturn 1° wide; the separation of 89° is decreasing
+	sq := grid[1]
+	if sq.Body2 != 2 || sq.Aspect.Name != "Square" || math.Abs(sq.Orb-1) > 1e-9 || sq.OutOfSign || !sq.Separating {
+		t.Errorf("Unexpected square %+v", sq)
+	}
+
+	// The part opposes the Sun 5° wide within an orb of (10 + 2) / 2 = 6°,
+	// but not Mars 7° wide with an orb of 5°
+	opp := grid[2]
+	if opp.Body1 != 0 || opp.Body2 != 3 || opp.Aspect.Name != "Opposition" || math.Abs(opp.Orb-5) > 1e-9 {
+		t.Errorf("Unexpected opposition %+v", opp)
+	}
+	// Mars in Taurus square Saturn in Cancer is out of sign
+	if grid[3].Body1 != 1 || grid[3].Body2 != 2 || !grid[3].OutOfSign {
+		t.Errorf("Unexpected aspect %+v", grid[3])
+	}
+}
+
+func TestCalcAspects_Orbs(t *testing.T) {
+	aspects := DefaultAspects()
+	if orb := aspects[0].OrbFor(ClassLuminary, ClassStar); orb != 5.5 {
+		t.Errorf("Expected a conjunction orb of 5.5° between the Sun and a star, got %.2f°", orb)
+	}
+
+	// A star 3° from the Sun is within orb, 5° from Venus out of orb
+	points := []ChartPoint{
+		{Name: "Sun", Class: ClassLuminary, Lon: 100},
+		{Name: "Star", Class: ClassStar, Lon: 103},
+		{Name: "Venus", Class: ClassPersonal, Lon: 108},
+	}
+	grid := CalcAspects(points, aspects)
+	if len(grid) != 2 || grid[0].Body2 != 1 || grid[1].Body1 != 0 || grid[1].Body2 != 2 {
+		t.Errorf("Unexpected aspects %+v", grid)
+	}
+
+	// Fixed points are neither applying nor separating
+	if grid[0].Applying || grid[0].Separating {
+		t.Errorf("Expected no motion for fixed points: %+v", grid[0])
+	}
+}
+
+func TestHarmonicAspectTable(t *testing.T) {
+	septiles := HarmonicAspectTable(7, 1)
+	if len(septiles) != 3 || septiles[1].Name != "2/7" || math.Abs(septiles[1].Angle-720.0/7) > 1e-12 {
+		t.Errorf("Unexpected septiles %+v", septiles)
+	}
+
+	// The 8th harmonic adds the semisquare and sesquiquadrate only
+	octiles := HarmonicAspectTable(8, 1)
+	if len(octiles) != 2 || octiles[0].Angle != 45 || octiles[1].Angle != 135 {
+		t.Errorf("Unexpected 8th harmonic aspects %+v", octiles)
+	}
+
+	points := []ChartPoint{{Lon: 10}, {Lon: 10 + 360.0/7 + 0.5}}
+	grid := CalcAspects(points, septiles)
+	if len(grid) != 1 || grid[0].Aspect.Kind != AspectHarmonic || grid[0].OutOfSign {
+		t.Errorf("Expected a septile, got %+v", grid)
+	}
+}
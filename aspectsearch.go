@@ -0,0 +1,261 @@
+// Go Swiss Ephemeris - Aspect Search
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"errors"
+	"math"
+)
+
+// PointKind is the kind of an AspectPoint
+type PointKind int
+
+const (
+	PointBody  PointKind = iota // A planet, asteroid or node calculated with CalcUT
+	PointStar                   // A fixed star calculated with FixstarUT
+	PointAngle                  // A house angle such as the Ascendant or MC
+	PointFixed                  // A fixed longitude, such as a natal position
+)
+
+// AspectPoint is one of the two points of an aspect search
+type AspectPoint struct {
+	Kind   PointKind
+	Body   int32   // Planet number (PointBody)
+	Star   string  // Star name (PointStar)
+	Angle  int     // Index into HousesResult.Points, such as Asc or MC (PointAngle)
+	Geolat float64 // Geographic latitude (PointAngle)
+	Geolon float64 // Geographic longitude (PointAngle)
+	Lon    float64 // Ecliptic longitude (PointFixed)
+}
+
+// BodyPoint returns an AspectPoint for a planet number
+func BodyPoint(body int32) AspectPoint {
+	return AspectPoint{Kind: PointBody, Body: body}
+}
+
+// StarPoint returns an AspectPoint for a fixed star name
+func StarPoint(star string) AspectPoint {
+	return AspectPoint{Kind: PointStar, Star: star}
+}
+
+// AnglePoint returns an AspectPoint for a house angle (Asc, MC, Vertex, ...)
+// at a geographic location
+func AnglePoint(angle int, geolat, geolon float64) AspectPoint {
+	return AspectPoint{Kind: PointAngle, Angle: angle, Geolat: geolat, Geolon: geolon}
+}
+
+// FixedPoint returns an AspectPoint for a fixed ecliptic longitude
+func FixedPoint(lon float64) AspectPoint {
+	return AspectPoint{Kind: PointFixed, Lon: lon}
+}
+
+// errInvalidPoint is returned for an AspectPoint of unknown kind or angle
+var errInvalidPoint = errors.New("swisseph: invalid aspect point")
+
+// MajorAspects returns the Ptolemaic aspect angles 0°, 60°, 90°, 120° and 180°
+func MajorAspects() []float64 {
+	return []float64{0, 60, 90, 120, 180}
+}
+
+// HarmonicAspects returns the aspect angles of the n-th harmonic, the
+// multiples of 360°/n from 0° to 180°
+func HarmonicAspects(n int) []float64 {
+	if n <= 0 {
+		return nil
+	}
+	aspects := make([]float64, 0, n/2+1)
+	for k := 0; 2*k <= n; k++ {
+		aspects = append(aspects, float64(k)*360/float64(n))
+	}
+	return aspects
+}
+
+// AspectEventType is the kind of an AspectEvent
+type AspectEventType int
+
+const (
+	AspectExact AspectEventType = iota // The aspect is exact
+	AspectEnter                        // The separation comes within orb of the aspect
+	AspectLeave                        // The separation goes out of orb of the aspect
+)
+
+// String returns the name of the event type
+func (t AspectEventType) String() string {
+	switch t {
+	case AspectExact:
+		return "exact"
+	case AspectEnter:
+		return "entering orb"
+	case AspectLeave:
+		return "leaving orb"
+	}
+	return "unknown"
+}
+
+// AspectEvent is a moment of an aspect between two points
+type AspectEvent struct {
+	JD        float64         // Julian day (UT) of the event
+	Type      AspectEventType // Exact, entering or leaving orb
+	Aspect    float64         // Aspect angle
+	Lon1      float64         // Longitude of the first point
+	Lon2      float64         // Longitude of the second point
+	Direction Direction       // Direct if the separation Lon1 - Lon2 is increasing
+}
+
+// FindAspects finds all moments between startJD and endJD (UT) when the
+// separation in longitude between p1 and p2 equals one of the aspect angles,
+// in time order. Every pass is reported, so an aspect repeated because of a
+// retrograde motion appears up to three times.
+//
+// Aspects default to MajorAspects. An aspect a is found at separations a and
+// 360° - a. If orb is positive, the moments the separation comes within and
+// goes out of orb of an aspect are reported as well.
+//
+// If a calculation fell back to another ephemeris, the events are returned
+// together with a warning error (see IsWarning).
+func FindAspects(startJD, endJD float64, p1, p2 AspectPoint, aspects []float64, orb float64, flags int32) ([]AspectEvent, error) {
+	if endJD <= startJD {
+		return nil, errInvalidRange
+	}
+	if err := p1.validate(); err != nil {
+		return nil, err
+	}
+	if err := p2.validate(); err != nil {
+		return nil, err
+	}
+	if len(aspects) == 0 {
+		aspects = MajorAspects()
+	}
+
+	// Each boundary is a separation at which an event happens
+	type boundary struct {
+		aspect float64
+		offset float64 // -orb, 0 or +orb
+	}
+	var bounds []boundary
+	var seps []float64
+	for _, a := range aspects {
+		a = math.Abs(angleDiff(a, 0))
+		exact := []float64{a}
+		if a != 0 && a != 180 {
+			exact = append(exact, 360-a)
+		}
+		for _, sep := range exact {
+			bounds = append(bounds, boundary{aspect: a})
+			seps = append(seps, sep)
+			if orb > 0 {
+				bounds = append(bounds, boundary{aspect: a, offset: -orb}, boundary{aspect: a, offset: orb})
+				seps = append(seps, normDeg(sep-orb), normDeg(sep+orb))
+			}
+		}
+	}
+
+	c := &calculator{iflag: (flags | FlagSpeed) &^ (FlagXYZ | FlagRadians | FlagEquatorial)}
+	var lon1, lon2 float64
+	sepAt := func(jd float64) (float64, float64, error) {
+		var speed1, speed2 float64
+		var err error
+		if lon1, speed1, err = c.point(jd, p1); err != nil {
+			return 0, 0, err
+		}
+		if lon2, speed2, err = c.point(jd, p2); err != nil {
+			return 0, 0, err
+		}
+		return normDeg(lon1 - lon2), speed1 - speed2, nil
+	}
+
+	step := math.Min(p1.step(), p2.step())
+	crossings, err := findCrossings(startJD, endJD, step, sepAt, seps)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]AspectEvent, len(crossings))
+	for i, cr := range crossings {
+		if _, _, err := sepAt(cr.jd); err != nil {
+			return nil, err
+		}
+		b := bounds[cr.index]
+
+		ev := AspectEvent{JD: cr.jd, Type: AspectExact, Aspect: b.aspect, Lon1: lon1, Lon2: lon2, Direction: cr.direction}
+		// The separation moves towards the exact aspect when entering orb
+		switch {
+		case b.offset > 0:
+			ev.Type = AspectLeave
+			if ev.Direction == Retrograde {
+				ev.Type = AspectEnter
+			}
+		case b.offset < 0:
+			ev.Type = AspectEnter
+			if ev.Direction == Retrograde {
+				ev.Type = AspectLeave
+			}
+		}
+		events[i] = ev
+	}
+
+	return events, c.warn
+}
+
+// validate checks the kind and angle index of a point
+func (p AspectPoint) validate() error {
+	switch p.Kind {
+	case PointBody, PointStar, PointFixed:
+		return nil
+	case PointAngle:
+		if p.Angle >= 0 && p.Angle < Nascmc {
+			return nil
+		}
+	}
+	return errInvalidPoint
+}
+
+// step returns the sampling step in days for a point
+func (p AspectPoint) step() float64 {
+	switch p.Kind {
+	case PointBody:
+		return searchStep(p.Body)
+	case PointAngle:
+		// Angles move about 15° per hour
+		return 1.0 / 24
+	}
+	return 1.0
+}
+
+// point returns the longitude and longitude speed of p at jd
+func (c *calculator) point(jd float64, p AspectPoint) (float64, float64, error) {
+	switch p.Kind {
+	case PointStar:
+		result, err := FixstarUTE(p.Star, jd, c.iflag)
+		if err := c.check(err); err != nil {
+			return 0, 0, err
+		}
+		return result.Data[0], result.Data[3], nil
+	case PointAngle:
+		// The angles do not depend on the house system; Porphyry never
+		// fails at polar latitudes
+		result, err := HousesEx2E(jd, c.iflag&FlagSidereal, p.Geolat, p.Geolon, 'O')
+		if err := c.check(err); err != nil {
+			return 0, 0, err
+		}
+		return result.Points[p.Angle], result.PointSpeeds[p.Angle], nil
+	case PointFixed:
+		return p.Lon, 0, nil
+	}
+	return c.lon(jd, p.Body)
+}
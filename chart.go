@@ -0,0 +1,317 @@
+// Go Swiss Ephemeris - Charts
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"time"
+)
+
+// DefaultChartBodies returns the bodies of a chart by default: the Sun, the
+// Moon, the planets up to Pluto and the true node
+func DefaultChartBodies() []int32 {
+	return []int32{Sun, Moon, Mercury, Venus, Mars, Jupiter, Saturn, Uranus, Neptune, Pluto, TrueNode}
+}
+
+// chartConfig holds the settings of a chart built by NewChart
+type chartConfig struct {
+	hsys        byte
+	sidereal    bool
+	sidMode     int32
+	topocentric bool
+	bodies      []int32
+	aspects     []Aspect
+	flags       int32
+}
+
+func defaultChartConfig() chartConfig {
+	return chartConfig{
+		hsys:    'P',
+		bodies:  DefaultChartBodies(),
+		aspects: DefaultAspects(),
+		flags:   FlagSwieph,
+	}
+}
+
+func newChartConfig(opts []ChartOption) chartConfig {
+	cfg := defaultChartConfig()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// ChartOption configures a chart built by NewChart
+type ChartOption func(*chartConfig)
+
+// WithHouseSystem sets the house system (default 'P', Placidus)
+func WithHouseSystem(hsys byte) ChartOption {
+	return func(c *chartConfig) {
+		c.hsys = hsys
+	}
+}
+
+// WithSidereal uses the sidereal zodiac with the given sidereal mode
+// (SidmLahiri, ...) for bodies and houses
+func WithSidereal(sidMode int32) ChartOption {
+	return func(c *chartConfig) {
+		c.sidereal = true
+		c.sidMode = sidMode
+	}
+}
+
+// WithTopocentric calculates topocentric instead of geocentric positions
+func WithTopocentric() ChartOption {
+	return func(c *chartConfig) {
+		c.topocentric = true
+	}
+}
+
+// WithBodies sets the bodies of the chart (default DefaultChartBodies)
+func WithBodies(bodies ...int32) ChartOption {
+	return func(c *chartConfig) {
+		c.bodies = bodies
+	}
+}
+
+// WithAspects sets the aspects of the aspect grid (default DefaultAspects)
+func WithAspects(aspects ...Aspect) ChartOption {
+	return func(c *chartConfig) {
+		c.aspects = aspects
+	}
+}
+
+// WithChartFlags sets the ephemeris flag (default FlagSwieph) and additional
+// calculation flags such as FlagTruepos
+func WithChartFlags(flags int32) ChartOption {
+	return func(c *chartConfig) {
+		c.flags = flags
+	}
+}
+
+// ChartBody is a body in a chart
+type ChartBody struct {
+	Body       int32    // Planet number
+	Name       string   // Planet name
+	Position   Position // Position and speed
+	Retrograde bool     // True if the speed in longitude is negative
+	Sign       int      // Zodiac sign (0 = Aries)
+	SignDegree float64  // Degree within the sign
+	House      int      // House (1-12, or 1-36 for Gauquelin sectors)
+	HousePos   float64  // House position from HousePos, such as 10.5 for the middle of house 10
+}
+
+// Chart is a horoscope for a moment and a place
+type Chart struct {
+	JD          float64       // Julian day (UT)
+	Geopos      [3]float64    // Geographic longitude, latitude and altitude
+	HouseSystem byte          // House system
+	Flags       int32         // Calculation flags used for the bodies
+	Bodies      []ChartBody   // Bodies in the order requested
+	Cusps       []float64     // House cusps, Cusps[0] is the cusp of house 1
+	CuspSpeeds  []float64     // Speeds of the house cusps in degrees per day
+	Angles      []float64     // Ascendant, MC, ARMC, Vertex, ..., indexed by Asc, MC, ...
+	AngleSpeeds []float64     // Speeds of the angles in degrees per day
+	Aspects     []ChartAspect // Aspect grid of the bodies, pairs in body order
+	Eps         float64       // True obliquity of the ecliptic
+	Ayanamsa    float64       // Ayanamsa subtracted from the positions, 0 in the tropical zodiac
+	Harmonic    float64       // Harmonic of a chart from HarmonicChart, 0 for a radix chart
+	Division    int           // Division of a chart from VargaChart, 0 for a radix chart
+
+	opts []ChartOption // Options the chart was built with
+}
+
+// NewChart builds a chart for a moment and a geographic location given as
+// longitude, latitude and altitude.
+//
+// With WithSidereal or WithTopocentric, NewChart uses the sidereal mode or
+// the topocentric location like SetSidMode and SetTopo, and restores the
+// previous settings of the calling thread before it returns.
+//
+// If a calculation fell back to another ephemeris, the chart is returned
+// together with a warning error (see IsWarning).
+func NewChart(t time.Time, geopos [3]float64, opts ...ChartOption) (*Chart, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return nil, err
+	}
+	return NewChartJD(jd, geopos, opts...)
+}
+
+// NewChartJD is NewChart for a Julian day (UT)
+func NewChartJD(tjdUt float64, geopos [3]float64, opts ...ChartOption) (*Chart, error) {
+	// The sidereal mode and topocentric location are thread-local
+	defer lockThreadSettings()()
+
+	cfg, flags := applyChartConfig(opts, geopos)
+
+	c := &calculator{iflag: flags}
+	chart := &Chart{
+		JD:          tjdUt,
+		Geopos:      geopos,
+		HouseSystem: cfg.hsys,
+		Flags:       flags,
+		opts:        opts,
+	}
+
+	houses, err := HousesEx2E(tjdUt, flags&FlagSidereal, geopos[1], geopos[0], cfg.hsys)
+	if err != nil {
+		return nil, err
+	}
+	chart.Cusps = houses.Houses
+	chart.CuspSpeeds = houses.HouseSpeeds
+	chart.Angles = houses.Points
+	chart.AngleSpeeds = houses.PointSpeeds
+
+	// HousePos works with tropical positions and the true obliquity
+	nut, err := c.calc(tjdUt, EclNut)
+	if err != nil {
+		return nil, err
+	}
+	eps := nut[0]
+	armc := houses.Points[ARMC]
+	chart.Eps = eps
+
+	if cfg.sidereal {
+		ayan, err := GetAyanamsaExUTE(tjdUt, flags)
+		if err := c.check(err); err != nil {
+			return nil, err
+		}
+		chart.Ayanamsa = ayan.Data[0]
+	}
+	tropical := &calculator{iflag: flags &^ FlagSidereal}
+
+	chart.Bodies = make([]ChartBody, len(cfg.bodies))
+	for i, body := range cfg.bodies {
+		xx, err := c.calc(tjdUt, body)
+		if err != nil {
+			return nil, err
+		}
+
+		cb := ChartBody{
+			Body:       body,
+			Name:       GetPlanetName(body),
+			Position:   NewPosition(xx, flags),
+			Retrograde: xx[3] < 0,
+			Sign:       int(xx[0] / 30),
+		}
+		cb.SignDegree = xx[0] - float64(cb.Sign)*30
+
+		switch {
+		case cfg.sidereal && (cfg.hsys == 'W' || cfg.hsys == 'N'):
+			// Sign-based houses start at sidereal sign cusps, which
+			// HousePos does not know about
+			cb.HousePos = cuspHousePos(xx[0], chart.Cusps)
+		case cfg.sidereal:
+			if xx, err = tropical.calc(tjdUt, body); err != nil {
+				return nil, err
+			}
+			fallthrough
+		default:
+			if cb.HousePos, err = HousePos(armc, geopos[1], eps, cfg.hsys, xx[0], xx[1]); err != nil {
+				return nil, err
+			}
+		}
+		cb.House = int(cb.HousePos)
+
+		chart.Bodies[i] = cb
+	}
+
+	points := make([]ChartPoint, len(chart.Bodies))
+	for i, b := range chart.Bodies {
+		points[i] = b.Point()
+	}
+	chart.Aspects = CalcAspects(points, cfg.aspects)
+
+	if c.warn != nil {
+		return chart, c.warn
+	}
+	return chart, tropical.warn
+}
+
+// cuspHousePos returns the house position of a longitude between the cusps
+// along the ecliptic, in the format of HousePos
+func cuspHousePos(lon float64, cusps []float64) float64 {
+	for i := range cusps {
+		start := cusps[i]
+		width := normDeg(cusps[(i+1)%len(cusps)] - start)
+		if d := normDeg(lon - start); d < width {
+			return float64(i+1) + d/width
+		}
+	}
+	return 1
+}
+
+// Point returns the body as a ChartPoint for CalcAspects
+func (b ChartBody) Point() ChartPoint {
+	return ChartPoint{
+		Name:  b.Name,
+		Class: BodyClassOf(b.Body),
+		Lon:   b.Position.Lon,
+		Speed: b.Position.LonSpeed,
+	}
+}
+
+// Points returns the bodies of the chart followed by the Ascendant and the
+// MC as ChartPoints for CalcAspects. The angles have no speed in charts
+// whose houses were not calculated for a moment, such as progressed charts.
+func (c *Chart) Points() []ChartPoint {
+	points := make([]ChartPoint, 0, len(c.Bodies)+2)
+	for _, b := range c.Bodies {
+		points = append(points, b.Point())
+	}
+	asc := ChartPoint{Name: "Ascendant", Class: ClassAngle, Lon: c.Angles[Asc]}
+	mc := ChartPoint{Name: "MC", Class: ClassAngle, Lon: c.Angles[MC]}
+	if c.AngleSpeeds != nil {
+		asc.Speed, mc.Speed = c.AngleSpeeds[Asc], c.AngleSpeeds[MC]
+	}
+	return append(points, asc, mc)
+}
+
+// applyChartConfig builds the configuration of a chart and applies its
+// thread-local settings. It returns the flags for the bodies of the chart.
+// The caller must hold lockThreadSettings.
+func applyChartConfig(opts []ChartOption, geopos [3]float64) (chartConfig, int32) {
+	cfg := newChartConfig(opts)
+
+	flags := cfg.flags
+	if ephemerisBits(flags) == 0 {
+		flags |= FlagSwieph
+	}
+	flags = (flags | FlagSpeed) &^ (FlagEquatorial | FlagXYZ | FlagRadians)
+
+	if cfg.sidereal {
+		SetSidMode(cfg.sidMode, 0, 0)
+		flags |= FlagSidereal
+	}
+	if cfg.topocentric {
+		SetTopo(geopos[0], geopos[1], geopos[2])
+		flags |= FlagTopoctr
+	}
+
+	return cfg, flags
+}
+
+// lockThread locks the calling goroutine to its OS thread and applies the
+// thread-local settings of the chart, so that calculations with c.Flags
+// match the chart. The returned function restores the previous settings and
+// unlocks the thread.
+func (c *Chart) lockThread() func() {
+	unlock := lockThreadSettings()
+	applyChartConfig(c.opts, c.Geopos)
+	return unlock
+}
@@ -0,0 +1,150 @@
+// Go Swiss Ephemeris - Chart Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"runtime"
+	"testing"
+	"time"
+)
+
+var (
+	testChartTime   = time.Date(1990, 7, 15, 14, 30, 0, 0, time.UTC)
+	testChartGeopos = [3]float64{-0.1278, 51.5074, 0}
+)
+
+// inHouse reports whether lon lies between the cusp of a house and the next
+func inHouse(lon float64, cusps []float64, house int) bool {
+	start := cusps[house-1]
+	end := cusps[house%len(cusps)]
+	return normDeg(lon-start) < normDeg(end-start)
+}
+
+func TestNewChart(t *testing.T) {
+	chart, err := NewChart(testChartTime, testChartGeopos, WithChartFlags(FlagMoseph))
+	if err != nil {
+		t.Fatalf("NewChart failed: %v", err)
+	}
+
+	if len(chart.Bodies) != len(DefaultChartBodies()) || len(chart.Cusps) != 12 {
+		t.Fatalf("Unexpected chart size: %d bodies, %d cusps", len(chart.Bodies), len(chart.Cusps))
+	}
+
+	houses := HousesEx(chart.JD, 0, testChartGeopos[1], testChartGeopos[0], 'P')
+	if math.Abs(chart.Angles[Asc]-houses.Points[Asc]) > 1e-9 || math.Abs(chart.Cusps[9]-houses.Houses[9]) > 1e-9 {
+		t.Error("Chart houses differ from HousesEx")
+	}
+	if chart.AngleSpeeds[MC] < 350 {
+		t.Errorf("Unexpected MC speed %.4f°/day", chart.AngleSpeeds[MC])
+	}
+
+	for _, b := range chart.Bodies {
+		result := CalcUT(chart.JD, b.Body, FlagMoseph|FlagSpeed)
+		if math.Abs(b.Position.Lon-result.Data[0]) > 1e-9 {
+			t.Errorf("%s: longitude %.6f, CalcUT gives %.6f", b.Name, b.Position.Lon, result.Data[0])
+		}
+		if b.Retrograde != (result.Data[3] < 0) {
+			t.Errorf("%s: wrong retrograde state", b.Name)
+		}
+		if b.Sign != int(b.Position.Lon/30) || math.Abs(float64(b.Sign)*30+b.SignDegree-b.Position.Lon) > 1e-9 {
+			t.Errorf("%s: wrong sign %d and degree %.4f", b.Name, b.Sign, b.SignDegree)
+		}
+		// Bodies near the ecliptic lie between the cusps of their house
+		if math.Abs(b.Position.Lat) < 1 && !inHouse(b.Position.Lon, chart.Cusps, b.House) {
+			t.Errorf("%s at %.4f° is not in house %d", b.Name, b.Position.Lon, b.House)
+		}
+	}
+
+	for _, a := range chart.Aspects {
+		b1, b2 := chart.Bodies[a.Body1], chart.Bodies[a.Body2]
+		sep := math.Abs(angleDiff(b1.Position.Lon, b2.Position.Lon))
+		if math.Abs(math.Abs(sep-a.Aspect.Angle)-a.Orb) > 1e-9 || a.Orb > a.Aspect.OrbFor(BodyClassOf(b1.Body), BodyClassOf(b2.Body)) {
+			t.Errorf("%s %s %s: wrong orb %.4f", b1.Name, a.Aspect.Name, b2.Name, a.Orb)
+		}
+	}
+	if len(chart.Aspects) == 0 {
+		t.Error("Expected some aspects")
+	}
+}
+
+func TestNewChart_Options(t *testing.T) {
+	tropical, err := NewChart(testChartTime, testChartGeopos, WithChartFlags(FlagMoseph), WithBodies(Sun, Moon))
+	if err != nil {
+		t.Fatalf("NewChart failed: %v", err)
+	}
+	sidereal, err := NewChart(testChartTime, testChartGeopos, WithChartFlags(FlagMoseph), WithBodies(Sun, Moon),
+		WithSidereal(SidmLahiri), WithHouseSystem('W'))
+	if err != nil {
+		t.Fatalf("NewChart failed: %v", err)
+	}
+
+	if len(sidereal.Bodies) != 2 || sidereal.Flags&FlagSidereal == 0 {
+		t.Fatalf("Unexpected sidereal chart %+v", sidereal)
+	}
+
+	// The Lahiri ayanamsa was about 23.7° in 1990
+	shift := angleDiff(tropical.Bodies[0].Position.Lon, sidereal.Bodies[0].Position.Lon)
+	if math.Abs(shift-23.7) > 0.1 {
+		t.Errorf("Unexpected shift of %.4f° between tropical and sidereal Sun", shift)
+	}
+
+	// Whole sign houses start at 0° of the sign of the sidereal Ascendant
+	if math.Mod(sidereal.Cusps[0], 30) != 0 || int(sidereal.Cusps[0]/30) != int(sidereal.Angles[Asc]/30) {
+		t.Errorf("Unexpected whole sign cusp %.4f for Ascendant %.4f", sidereal.Cusps[0], sidereal.Angles[Asc])
+	}
+	for _, b := range sidereal.Bodies {
+		if !inHouse(b.Position.Lon, sidereal.Cusps, b.House) {
+			t.Errorf("%s at %.4f° is not in whole sign house %d", b.Name, b.Position.Lon, b.House)
+		}
+	}
+
+	topo, err := NewChart(testChartTime, testChartGeopos, WithChartFlags(FlagMoseph), WithBodies(Moon), WithTopocentric())
+	if err != nil {
+		t.Fatalf("NewChart failed: %v", err)
+	}
+	// The lunar parallax is up to about 1°
+	if d := math.Abs(angleDiff(topo.Bodies[0].Position.Lon, tropical.Bodies[1].Position.Lon)); d == 0 || d > 1.1 {
+		t.Errorf("Unexpected topocentric shift of the Moon %.4f°", d)
+	}
+}
+
+func TestNewChart_ThreadSettings(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	SetSidMode(SidmRaman, 0, 0)
+	defer SetSidMode(SidmFaganBradley, 0, 0)
+
+	before := getThreadSettings()
+	ayanamsa := GetAyanamsaUT(2448088.0)
+
+	natal, err := NewChart(testChartTime, testChartGeopos, WithChartFlags(FlagMoseph), WithSidereal(SidmLahiri), WithTopocentric())
+	if err != nil {
+		t.Fatalf("NewChart failed: %v", err)
+	}
+	if _, err := Progress(natal, natal.JD+30*TropicalYear, ProgressionOptions{}); err != nil {
+		t.Fatalf("Progress failed: %v", err)
+	}
+
+	if getThreadSettings() != before {
+		t.Error("Chart calculations changed the settings of the calling thread")
+	}
+	if a := GetAyanamsaUT(2448088.0); a != ayanamsa {
+		t.Errorf("Ayanamsa changed from %.6f° to %.6f°", ayanamsa, a)
+	}
+}
@@ -0,0 +1,360 @@
+// Go Swiss Ephemeris - Dashas
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import "math"
+
+// Year lengths of dasha periods in days
+const (
+	JulianYear   = 365.25     // Julian year, the usual dasha year
+	SavanaYear   = 360.0      // Savana year of 360 civil days
+	SiderealYear = 365.256363 // Sidereal year
+)
+
+// DashaSystem selects a nakshatra-based dasha system
+type DashaSystem int
+
+const (
+	Vimshottari DashaSystem = iota // 120 years of nine lords from Ketu
+	Yogini                         // 36 years of eight yoginis
+	Ashtottari                     // 108 years of eight lords, counted from Ardra
+)
+
+// DashaOptions configures the dasha calculations
+type DashaOptions struct {
+	System     DashaSystem // Dasha system for Dashas and MoonDashas
+	Levels     int         // Levels of sub-periods, 1 for mahadashas only; defaults to 3 (pratyantardashas)
+	YearLength float64     // Length of a dasha year in days; defaults to JulianYear
+}
+
+// DashaPeriod is a period of a dasha system with its sub-periods
+type DashaPeriod struct {
+	Lord  Graha         // Lord of the period; for Chara dashas the lord of the sign
+	Name  string        // Name of the lord, yogini or sign
+	Sign  int           // Sign of a Chara dasha (0 = Aries), -1 for other systems
+	Level int           // 1 for a mahadasha, 2 for an antardasha, 3 for a pratyantardasha, ...
+	Start float64       // Julian day (UT) the period starts
+	End   float64       // Julian day (UT) the period ends
+	Years float64       // Length of the period in dasha years
+	Sub   []DashaPeriod // Sub-periods
+}
+
+// dashaScheme is a cycle of lords with the years of their periods
+type dashaScheme struct {
+	lords []Graha
+	names []string
+	years []float64
+}
+
+func (s dashaScheme) total() float64 {
+	total := 0.0
+	for _, y := range s.years {
+		total += y
+	}
+	return total
+}
+
+var (
+	vimshottariScheme = dashaScheme{
+		lords: vimshottariLords[:],
+		years: []float64{7, 20, 6, 10, 7, 18, 16, 19, 17},
+	}
+	yoginiScheme = dashaScheme{
+		lords: []Graha{GrahaMoon, GrahaSun, GrahaJupiter, GrahaMars, GrahaMercury, GrahaSaturn, GrahaVenus, GrahaRahu},
+		names: []string{"Mangala", "Pingala", "Dhanya", "Bhramari", "Bhadrika", "Ulka", "Siddha", "Sankata"},
+		years: []float64{1, 2, 3, 4, 5, 6, 7, 8},
+	}
+	ashtottariScheme = dashaScheme{
+		lords: []Graha{GrahaSun, GrahaMoon, GrahaMars, GrahaMercury, GrahaSaturn, GrahaJupiter, GrahaRahu, GrahaVenus},
+		years: []float64{6, 15, 8, 17, 10, 19, 12, 21},
+	}
+)
+
+// ashtottariGroups are the first nakshatras and the number of nakshatras of
+// the Ashtottari lords, from Ardra. Abhijit lies in the group of Saturn.
+var ashtottariGroups = [8]struct{ first, count int }{
+	{5, 4}, {9, 3}, {12, 4}, {16, 3}, {19, 3}, {22, 3}, {25, 4}, {2, 3},
+}
+
+// periods returns a cycle of periods of the scheme from the lord at first,
+// together lasting length years from start
+func (s dashaScheme) periods(first int, start, length, yearLength float64, level, levels int) []DashaPeriod {
+	total := s.total()
+	out := make([]DashaPeriod, len(s.lords))
+	t := start
+	for k := range s.lords {
+		i := (first + k) % len(s.lords)
+		years := length * s.years[i] / total
+		p := DashaPeriod{
+			Lord:  s.lords[i],
+			Name:  s.lords[i].String(),
+			Sign:  -1,
+			Level: level,
+			Start: t,
+			End:   t + years*yearLength,
+			Years: years,
+		}
+		if s.names != nil {
+			p.Name = s.names[i]
+		}
+		if level < levels {
+			p.Sub = s.periods(i, p.Start, years, yearLength, level+1, levels)
+		}
+		out[k] = p
+		t = p.End
+	}
+	return out
+}
+
+// Dashas returns a full cycle of mahadashas of a birth at birthJD (UT) with
+// the Moon at the sidereal longitude moonLon. The first mahadasha starts
+// before birth by the part of it elapsed at birth: the part of the Moon's
+// nakshatra traversed, or of its group of nakshatras for Ashtottari.
+func Dashas(birthJD, moonLon float64, opts DashaOptions) []DashaPeriod {
+	levels, yearLength := opts.Levels, opts.YearLength
+	if levels <= 0 {
+		levels = 3
+	}
+	if yearLength <= 0 {
+		yearLength = JulianYear
+	}
+
+	moonLon = normDeg(moonLon)
+	n := NakshatraAt(moonLon, Nakshatras27)
+
+	var scheme dashaScheme
+	var first int
+	var elapsed float64
+	switch opts.System {
+	case Yogini:
+		scheme, first, elapsed = yoginiScheme, (n.Index+3)%8, n.Fraction()
+	case Ashtottari:
+		scheme = ashtottariScheme
+		for i, g := range ashtottariGroups {
+			start := float64(g.first) * NakshatraSpan
+			width := float64(g.count) * NakshatraSpan
+			if d := normDeg(moonLon - start); d < width {
+				first, elapsed = i, d/width
+				break
+			}
+		}
+	default:
+		scheme, first, elapsed = vimshottariScheme, n.Index%9, n.Fraction()
+	}
+
+	start := birthJD - elapsed*scheme.years[first]*yearLength
+	return scheme.periods(first, start, scheme.total(), yearLength, 1, levels)
+}
+
+// MoonDashas returns Dashas for the Moon at birthJD (UT) in the sidereal
+// zodiac of sidMode (SidmLahiri, ...). The flags select the ephemeris and
+// default to FlagSwieph.
+func MoonDashas(birthJD float64, sidMode int32, flags int32, opts DashaOptions) ([]DashaPeriod, error) {
+	c, unlock := lockSidereal(sidMode, flags)
+	defer unlock()
+
+	lon, _, err := c.lon(birthJD, Moon)
+	if err != nil {
+		return nil, err
+	}
+	return Dashas(birthJD, lon, opts), c.warn
+}
+
+// DashaAt returns the periods running at jd, from the mahadasha down to the
+// deepest sub-period, or nil if jd is outside the periods
+func DashaAt(periods []DashaPeriod, jd float64) []DashaPeriod {
+	var chain []DashaPeriod
+	for len(periods) > 0 {
+		found := false
+		for _, p := range periods {
+			if jd >= p.Start && jd < p.End {
+				chain = append(chain, p)
+				periods = p.Sub
+				found = true
+				break
+			}
+		}
+		if !found {
+			break
+		}
+	}
+	return chain
+}
+
+// savyaSigns are the odd-footed signs, counted forward in Chara dasha
+var savyaSigns = [12]bool{true, true, true, false, false, false, true, true, true, false, false, false}
+
+// CharaDasha returns the Chara dashas of Jaimini for a chart, after
+// K. N. Rao: a cycle of the 12 signs from the sign of the Ascendant, forward
+// if the 9th sign from it is odd-footed (Aries to Gemini, Libra to
+// Sagittarius) and backward otherwise. A sign lasts as many years as its
+// lord is signs away from it, counted forward from odd-footed signs and
+// backward from the others, or 12 if the lord is in the sign, plus one if
+// the lord is exalted and minus one if it is debilitated. Of the co-lords of Scorpio and Aquarius the one
+// outside the sign is used, or else the one with more grahas in its sign,
+// or else the one further advanced in its sign.
+//
+// The antardashas are the 12 signs from the one after the mahadasha sign,
+// counted in the direction of the mahadasha sign, each a twelfth of it.
+// Use a sidereal chart built with WithSidereal.
+func CharaDasha(chart *Chart, opts DashaOptions) ([]DashaPeriod, error) {
+	defer chart.lockThread()()
+
+	levels, yearLength := opts.Levels, opts.YearLength
+	if levels <= 0 {
+		levels = 3
+	}
+	if yearLength <= 0 {
+		yearLength = JulianYear
+	}
+
+	c := &calculator{iflag: chart.Flags}
+	var lons [9]float64
+	for g := GrahaSun; g <= GrahaKetu; g++ {
+		lon, _, err := c.grahaLon(chart.JD, g)
+		if err != nil {
+			return nil, err
+		}
+		lons[g] = lon
+	}
+
+	lagna := int(normDeg(chart.Angles[Asc]) / 30)
+	dir := 1
+	if !savyaSigns[(lagna+8)%12] {
+		dir = -1
+	}
+
+	names := SignNames()
+	var periods []DashaPeriod
+	t := chart.JD
+	for k := 0; k < 12; k++ {
+		sign := (lagna + dir*k + 12) % 12
+		lord := charaLord(sign, lons)
+		years := charaYears(sign, lord, lons)
+		p := DashaPeriod{
+			Lord:  lord,
+			Name:  names[sign],
+			Sign:  sign,
+			Level: 1,
+			Start: t,
+			End:   t + years*yearLength,
+			Years: years,
+		}
+		if levels > 1 {
+			p.Sub = charaSubPeriods(sign, p.Start, years, yearLength, 2, levels, lons)
+		}
+		periods = append(periods, p)
+		t = p.End
+	}
+
+	return periods, c.warn
+}
+
+// charaSubPeriods returns the 12 sub-periods of a Chara dasha period
+func charaSubPeriods(sign int, start, years, yearLength float64, level, levels int, lons [9]float64) []DashaPeriod {
+	dir := 1
+	if !savyaSigns[sign] {
+		dir = -1
+	}
+	names := SignNames()
+	out := make([]DashaPeriod, 12)
+	t := start
+	for k := range out {
+		s := (sign + dir*(k+1) + 12) % 12
+		p := DashaPeriod{
+			Lord:  charaLord(s, lons),
+			Name:  names[s],
+			Sign:  s,
+			Level: level,
+			Start: t,
+			End:   t + years/12*yearLength,
+			Years: years / 12,
+		}
+		if level < levels {
+			p.Sub = charaSubPeriods(s, p.Start, p.Years, yearLength, level+1, levels, lons)
+		}
+		out[k] = p
+		t = p.End
+	}
+	return out
+}
+
+// charaLord returns the lord of a sign, choosing between the co-lords of
+// Scorpio and Aquarius
+func charaLord(sign int, lons [9]float64) Graha {
+	lord := signLords[sign]
+	var node Graha
+	switch sign {
+	case 7:
+		node = GrahaKetu
+	case 10:
+		node = GrahaRahu
+	default:
+		return lord
+	}
+
+	signOf := func(g Graha) int { return int(lons[g] / 30) }
+	switch {
+	case signOf(lord) == sign && signOf(node) != sign:
+		return node
+	case signOf(node) == sign && signOf(lord) != sign:
+		return lord
+	}
+
+	occupants := func(g Graha) int {
+		n := 0
+		for o := range lons {
+			if Graha(o) != g && signOf(Graha(o)) == signOf(g) {
+				n++
+			}
+		}
+		return n
+	}
+	if n1, n2 := occupants(lord), occupants(node); n1 != n2 {
+		if n2 > n1 {
+			return node
+		}
+		return lord
+	}
+	if math.Mod(lons[node], 30) > math.Mod(lons[lord], 30) {
+		return node
+	}
+	return lord
+}
+
+// charaYears returns the years of the Chara dasha of a sign
+func charaYears(sign int, lord Graha, lons [9]float64) float64 {
+	lordSign := int(lons[lord] / 30)
+	count := (lordSign - sign + 12) % 12
+	if !savyaSigns[sign] {
+		count = (sign - lordSign + 12) % 12
+	}
+	years := float64(count)
+	if count == 0 {
+		years = 12
+	}
+	if lord <= GrahaSaturn {
+		switch lordSign {
+		case exaltationSigns[lord]:
+			years++
+		case (exaltationSigns[lord] + 6) % 12:
+			years--
+		}
+	}
+	return years
+}
@@ -0,0 +1,175 @@
+// Go Swiss Ephemeris - Dasha Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+// checkDashaTree checks that sub-periods fill their periods
+func checkDashaTree(t *testing.T, periods []DashaPeriod, yearLength float64) {
+	t.Helper()
+	for i, p := range periods {
+		if math.Abs(p.End-p.Start-p.Years*yearLength) > 1e-6 {
+			t.Errorf("%s: %.4f years from %.4f to %.4f", p.Name, p.Years, p.Start, p.End)
+		}
+		if i > 0 && math.Abs(p.Start-periods[i-1].End) > 1e-6 {
+			t.Errorf("%s does not start at the end of %s", p.Name, periods[i-1].Name)
+		}
+		if len(p.Sub) > 0 {
+			if p.Sub[0].Start != p.Start || math.Abs(p.Sub[len(p.Sub)-1].End-p.End) > 1e-6 {
+				t.Errorf("Sub-periods of %s do not fill it", p.Name)
+			}
+			checkDashaTree(t, p.Sub, yearLength)
+		}
+	}
+}
+
+func TestDashas(t *testing.T) {
+	birth := 2448088.0
+
+	tests := []struct {
+		system  DashaSystem
+		moonLon float64
+		first   string
+		lord    Graha
+		elapsed float64 // Years of the first mahadasha elapsed at birth
+		total   float64
+	}{
+		{Vimshottari, 0, "Ketu", GrahaKetu, 0, 120},
+		{Vimshottari, 40 + NakshatraSpan/2, "Moon", GrahaMoon, 5, 120},
+		{Vimshottari, 359.999999, "Mercury", GrahaMercury, 17, 120},
+		{Yogini, 0, "Bhramari", GrahaMars, 0, 36},
+		{Yogini, 4.5 * NakshatraSpan, "Sankata", GrahaRahu, 4, 36},
+		{Ashtottari, 5 * NakshatraSpan, "Sun", GrahaSun, 0, 108},
+		{Ashtottari, 0, "Rahu", GrahaRahu, 6, 108},
+		{Ashtottari, 280, "Saturn", GrahaSaturn, 10 * (280 - 19*NakshatraSpan) / 40, 108},
+	}
+	for _, test := range tests {
+		periods := Dashas(birth, test.moonLon, DashaOptions{System: test.system})
+		first := periods[0]
+		if first.Name != test.first || first.Lord != test.lord || first.Sign != -1 {
+			t.Errorf("System %d, Moon at %.2f: first dasha %s, expected %s", test.system, test.moonLon, first.Name, test.first)
+			continue
+		}
+		if elapsed := (birth - first.Start) / JulianYear; math.Abs(elapsed-test.elapsed) > 1e-5 {
+			t.Errorf("System %d, Moon at %.2f: %.6f years elapsed, expected %.6f", test.system, test.moonLon, elapsed, test.elapsed)
+		}
+		total := 0.0
+		for _, p := range periods {
+			total += p.Years
+		}
+		if math.Abs(total-test.total) > 1e-9 {
+			t.Errorf("System %d: cycle of %.4f years", test.system, total)
+		}
+		checkDashaTree(t, periods, JulianYear)
+	}
+
+	// Ketu-Ketu-Ketu lasts 7 * 7/120 * 7/120 years
+	periods := Dashas(birth, 0, DashaOptions{Levels: 3, YearLength: SavanaYear})
+	chain := DashaAt(periods, birth)
+	if len(chain) != 3 || chain[2].Lord != GrahaKetu || chain[2].Level != 3 {
+		t.Fatalf("Unexpected running periods %+v", chain)
+	}
+	if want := 7 * 7.0 / 120 * 7 / 120; math.Abs(chain[2].Years-want) > 1e-12 || math.Abs(chain[2].End-chain[2].Start-want*SavanaYear) > 1e-9 {
+		t.Errorf("Pratyantardasha of %.6f years, expected %.6f", chain[2].Years, want)
+	}
+	if chain := DashaAt(periods, birth+8*SavanaYear); len(chain) != 3 || chain[0].Lord != GrahaVenus || chain[1].Lord != GrahaVenus {
+		t.Errorf("Unexpected periods after 8 years %+v", chain)
+	}
+	if DashaAt(periods, birth-1) != nil {
+		t.Error("Periods running before the cycle")
+	}
+	if len(Dashas(birth, 0, DashaOptions{Levels: 1})[0].Sub) != 0 {
+		t.Error("Sub-periods with one level")
+	}
+}
+
+func TestMoonDashas(t *testing.T) {
+	natal := testNatalChart(t, WithSidereal(SidmLahiri), WithBodies(Moon))
+
+	periods, err := MoonDashas(natal.JD, SidmLahiri, FlagMoseph, DashaOptions{Levels: 2})
+	if err != nil {
+		t.Fatalf("MoonDashas failed: %v", err)
+	}
+	want := Dashas(natal.JD, natal.Bodies[0].Position.Lon, DashaOptions{Levels: 2})
+	if periods[0].Lord != want[0].Lord || math.Abs(periods[0].Start-want[0].Start) > 1e-6 {
+		t.Errorf("MoonDashas starts with %s at %.4f, expected %s at %.4f",
+			periods[0].Name, periods[0].Start, want[0].Name, want[0].Start)
+	}
+}
+
+func TestCharaYears(t *testing.T) {
+	var lons [9]float64
+	place := func(g Graha, sign int) { lons[g] = float64(sign)*30 + 15 }
+
+	place(GrahaMars, 4) // Leo
+	if y := charaYears(0, GrahaMars, lons); y != 4 {
+		t.Errorf("Aries with Mars in Leo: %.0f years, expected 4", y)
+	}
+	place(GrahaMars, 9) // Exalted in Capricorn
+	if y := charaYears(0, GrahaMars, lons); y != 10 {
+		t.Errorf("Aries with Mars in Capricorn: %.0f years, expected 10", y)
+	}
+	place(GrahaMoon, 5) // Cancer counts backward to Virgo
+	if y := charaYears(3, GrahaMoon, lons); y != 10 {
+		t.Errorf("Cancer with the Moon in Virgo: %.0f years, expected 10", y)
+	}
+	place(GrahaMoon, 3)
+	if y := charaYears(3, GrahaMoon, lons); y != 12 {
+		t.Errorf("Cancer with the Moon in Cancer: %.0f years, expected 12", y)
+	}
+
+	place(GrahaMars, 7) // Mars in Scorpio, so Ketu rules it
+	place(GrahaKetu, 2)
+	if lord := charaLord(7, lons); lord != GrahaKetu {
+		t.Errorf("Lord of Scorpio is %s, expected Ketu", lord)
+	}
+	place(GrahaMars, 0)
+	place(GrahaSun, 0) // Mars has company in Aries
+	if lord := charaLord(7, lons); lord != GrahaMars {
+		t.Errorf("Lord of Scorpio is %s, expected Mars", lord)
+	}
+}
+
+func TestCharaDasha(t *testing.T) {
+	natal := testNatalChart(t, WithSidereal(SidmLahiri))
+
+	periods, err := CharaDasha(natal, DashaOptions{Levels: 2})
+	if err != nil {
+		t.Fatalf("CharaDasha failed: %v", err)
+	}
+	if len(periods) != 12 || periods[0].Start != natal.JD {
+		t.Fatalf("Unexpected Chara dashas %+v", periods)
+	}
+	lagna := int(natal.Angles[Asc] / 30)
+	if periods[0].Sign != lagna {
+		t.Errorf("First Chara dasha of %s, expected the sign of the Ascendant %d", periods[0].Name, lagna)
+	}
+	step := (periods[1].Sign - periods[0].Sign + 12) % 12
+	if step != 1 && step != 11 {
+		t.Errorf("Chara dashas do not follow the signs: %d then %d", periods[0].Sign, periods[1].Sign)
+	}
+	for _, p := range periods {
+		if p.Years < 0 || p.Years > 13 || len(p.Sub) != 12 || p.Sub[11].Sign != p.Sign {
+			t.Errorf("Unexpected Chara dasha %s of %.0f years", p.Name, p.Years)
+		}
+	}
+	checkDashaTree(t, periods, JulianYear)
+}
@@ -72,6 +72,12 @@ func SolEclipseWhenLoc(tjdStart float64, ifl int32, geopos [3]float64, backward
 	return result
 }
 
+// SolEclipseWhenLocE is SolEclipseWhenLoc returning an error if the calculation failed
+func SolEclipseWhenLocE(tjdStart float64, ifl int32, geopos [3]float64, backward bool) (EclipseResult, error) {
+	result := SolEclipseWhenLoc(tjdStart, ifl, geopos, backward)
+	return result, newError("swe_sol_eclipse_when_loc", result.Flag, result.Error, result.Flag < 0)
+}
+
 // SolEclipseWhenGlob finds the next solar eclipse globally
 func SolEclipseWhenGlob(tjdStart float64, ifl int32, ifltype int32, backward bool) EclipseResult {
 	var tret [10]C.double
@@ -105,6 +111,12 @@ func SolEclipseWhenGlob(tjdStart float64, ifl int32, ifltype int32, backward boo
 	return result
 }
 
+// SolEclipseWhenGlobE is SolEclipseWhenGlob returning an error if the calculation failed
+func SolEclipseWhenGlobE(tjdStart float64, ifl int32, ifltype int32, backward bool) (EclipseResult, error) {
+	result := SolEclipseWhenGlob(tjdStart, ifl, ifltype, backward)
+	return result, newError("swe_sol_eclipse_when_glob", result.Flag, result.Error, result.Flag < 0)
+}
+
 // SolEclipseHow calculates the attributes of a solar eclipse at a given location
 func SolEclipseHow(tjdUt float64, ifl int32, geopos [3]float64) EclipseResult {
 	var attr [20]C.double
@@ -136,6 +148,12 @@ func SolEclipseHow(tjdUt float64, ifl int32, geopos [3]float64) EclipseResult {
 	return result
 }
 
+// SolEclipseHowE is SolEclipseHow returning an error if the calculation failed
+func SolEclipseHowE(tjdUt float64, ifl int32, geopos [3]float64) (EclipseResult, error) {
+	result := SolEclipseHow(tjdUt, ifl, geopos)
+	return result, newError("swe_sol_eclipse_how", result.Flag, result.Error, result.Flag < 0)
+}
+
 // SolEclipseWhere calculates where a solar eclipse is central or maximal
 func SolEclipseWhere(tjdUt float64, ifl int32) EclipseWhereResult {
 	var geopos [2]C.double
@@ -165,6 +183,12 @@ func SolEclipseWhere(tjdUt float64, ifl int32) EclipseWhereResult {
 	return result
 }
 
+// SolEclipseWhereE is SolEclipseWhere returning an error if the calculation failed
+func SolEclipseWhereE(tjdUt float64, ifl int32) (EclipseWhereResult, error) {
+	result := SolEclipseWhere(tjdUt, ifl)
+	return result, newError("swe_sol_eclipse_where", result.Flag, result.Error, result.Flag < 0)
+}
+
 // LunEclipseWhen finds the next lunar eclipse
 func LunEclipseWhen(tjdStart float64, ifl int32, ifltype int32, backward bool) EclipseResult {
 	var tret [10]C.double
@@ -199,6 +223,12 @@ func LunEclipseWhen(tjdStart float64, ifl int32, ifltype int32, backward bool) E
 	return result
 }
 
+// LunEclipseWhenE is LunEclipseWhen returning an error if the calculation failed
+func LunEclipseWhenE(tjdStart float64, ifl int32, ifltype int32, backward bool) (EclipseResult, error) {
+	result := LunEclipseWhen(tjdStart, ifl, ifltype, backward)
+	return result, newError("swe_lun_eclipse_when", result.Flag, result.Error, result.Flag < 0)
+}
+
 // LunEclipseWhenLoc finds the next lunar eclipse for a given location
 func LunEclipseWhenLoc(tjdStart float64, ifl int32, geopos [3]float64, backward bool) EclipseResult {
 	var tret [10]C.double
@@ -244,6 +274,12 @@ func LunEclipseWhenLoc(tjdStart float64, ifl int32, geopos [3]float64, backward
 	return result
 }
 
+// LunEclipseWhenLocE is LunEclipseWhenLoc returning an error if the calculation failed
+func LunEclipseWhenLocE(tjdStart float64, ifl int32, geopos [3]float64, backward bool) (EclipseResult, error) {
+	result := LunEclipseWhenLoc(tjdStart, ifl, geopos, backward)
+	return result, newError("swe_lun_eclipse_when_loc", result.Flag, result.Error, result.Flag < 0)
+}
+
 // LunEclipseHow calculates the attributes of a lunar eclipse
 func LunEclipseHow(tjdUt float64, ifl int32, geopos [3]float64) EclipseResult {
 	var attr [20]C.double
@@ -275,6 +311,12 @@ func LunEclipseHow(tjdUt float64, ifl int32, geopos [3]float64) EclipseResult {
 	return result
 }
 
+// LunEclipseHowE is LunEclipseHow returning an error if the calculation failed
+func LunEclipseHowE(tjdUt float64, ifl int32, geopos [3]float64) (EclipseResult, error) {
+	result := LunEclipseHow(tjdUt, ifl, geopos)
+	return result, newError("swe_lun_eclipse_how", result.Flag, result.Error, result.Flag < 0)
+}
+
 // LunOccultWhenLoc finds the next lunar occultation for a given location
 func LunOccultWhenLoc(tjdStart float64, ipl int32, starname string, ifl int32, geopos [3]float64, backward bool) EclipseResult {
 	var tret [10]C.double
@@ -325,6 +367,12 @@ func LunOccultWhenLoc(tjdStart float64, ipl int32, starname string, ifl int32, g
 	return result
 }
 
+// LunOccultWhenLocE is LunOccultWhenLoc returning an error if the calculation failed
+func LunOccultWhenLocE(tjdStart float64, ipl int32, starname string, ifl int32, geopos [3]float64, backward bool) (EclipseResult, error) {
+	result := LunOccultWhenLoc(tjdStart, ipl, starname, ifl, geopos, backward)
+	return result, newError("swe_lun_occult_when_loc", result.Flag, result.Error, result.Flag < 0)
+}
+
 // LunOccultWhenGlob finds the next lunar occultation globally
 func LunOccultWhenGlob(tjdStart float64, ipl int32, starname string, ifl int32, ifltype int32, backward bool) EclipseResult {
 	var tret [10]C.double
@@ -363,6 +411,12 @@ func LunOccultWhenGlob(tjdStart float64, ipl int32, starname string, ifl int32,
 	return result
 }
 
+// LunOccultWhenGlobE is LunOccultWhenGlob returning an error if the calculation failed
+func LunOccultWhenGlobE(tjdStart float64, ipl int32, starname string, ifl int32, ifltype int32, backward bool) (EclipseResult, error) {
+	result := LunOccultWhenGlob(tjdStart, ipl, starname, ifl, ifltype, backward)
+	return result, newError("swe_lun_occult_when_glob", result.Flag, result.Error, result.Flag < 0)
+}
+
 // LunOccultWhere calculates where a lunar occultation is central or maximal
 func LunOccultWhere(tjdUt float64, ipl int32, starname string, ifl int32) EclipseWhereResult {
 	var geopos [2]C.double
@@ -396,3 +450,9 @@ func LunOccultWhere(tjdUt float64, ipl int32, starname string, ifl int32) Eclips
 
 	return result
 }
+
+// LunOccultWhereE is LunOccultWhere returning an error if the calculation failed
+func LunOccultWhereE(tjdUt float64, ipl int32, starname string, ifl int32) (EclipseWhereResult, error) {
+	result := LunOccultWhere(tjdUt, ipl, starname, ifl)
+	return result, newError("swe_lun_occult_where", result.Flag, result.Error, result.Flag < 0)
+}
@@ -0,0 +1,330 @@
+// Go Swiss Ephemeris - Thread-Pinned Ephemeris Handle
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+)
+
+// ErrEphemerisClosed is returned when a call is made on a closed Ephemeris
+var ErrEphemerisClosed = errors.New("swisseph: ephemeris is closed")
+
+// ephemerisConfig holds the settings an Ephemeris applies to its OS thread
+type ephemerisConfig struct {
+	ephePath   string
+	jplFile    string
+	sidMode    int32
+	sidT0      float64
+	sidAyanT0  float64
+	topoSet    bool
+	topoLon    float64
+	topoLat    float64
+	topoAlt    float64
+	tidAcc     float64
+	deltaTUser float64
+}
+
+func defaultEphemerisConfig() ephemerisConfig {
+	return ephemerisConfig{
+		sidMode:    SidmFaganBradley,
+		tidAcc:     TidalAutomatic,
+		deltaTUser: DeltatAutomatic,
+	}
+}
+
+// EphemerisOption configures an Ephemeris created by NewEphemeris
+type EphemerisOption func(*ephemerisConfig)
+
+// WithEphePath sets the directory path for ephemeris files
+func WithEphePath(path string) EphemerisOption {
+	return func(c *ephemerisConfig) {
+		c.ephePath = path
+	}
+}
+
+// WithJplFile sets the JPL ephemeris file name
+func WithJplFile(fname string) EphemerisOption {
+	return func(c *ephemerisConfig) {
+		c.jplFile = fname
+	}
+}
+
+// WithSidMode sets the sidereal mode used with FlagSidereal
+func WithSidMode(sidMode int32, t0 float64, ayanT0 float64) EphemerisOption {
+	return func(c *ephemerisConfig) {
+		c.sidMode = sidMode
+		c.sidT0 = t0
+		c.sidAyanT0 = ayanT0
+	}
+}
+
+// WithTopo sets the geographic location used with FlagTopoctr
+func WithTopo(geoLon, geoLat, altitude float64) EphemerisOption {
+	return func(c *ephemerisConfig) {
+		c.topoSet = true
+		c.topoLon = geoLon
+		c.topoLat = geoLat
+		c.topoAlt = altitude
+	}
+}
+
+// WithTidAcc sets the tidal acceleration value
+func WithTidAcc(tidAcc float64) EphemerisOption {
+	return func(c *ephemerisConfig) {
+		c.tidAcc = tidAcc
+	}
+}
+
+// WithDeltaTUserdef sets a user-defined Delta T value
+func WithDeltaTUserdef(dt float64) EphemerisOption {
+	return func(c *ephemerisConfig) {
+		c.deltaTUser = dt
+	}
+}
+
+// Ephemeris is a goroutine-safe handle to the Swiss Ephemeris.
+//
+// The C library keeps its settings (ephemeris path, sidereal mode, topocentric
+// position, tidal acceleration and Delta T) in thread-local storage. An
+// Ephemeris owns a dedicated goroutine locked to its own OS thread and runs
+// every call there, so handles with different settings can be used
+// concurrently without affecting each other or the package-level functions.
+// Calls on a single Ephemeris are serialized.
+type Ephemeris struct {
+	cfg       ephemerisConfig
+	calls     chan func()
+	done      chan struct{}
+	closeOnce sync.Once
+	stopped   chan struct{}
+}
+
+// NewEphemeris creates an Ephemeris with its own OS thread and settings
+func NewEphemeris(opts ...EphemerisOption) *Ephemeris {
+	cfg := defaultEphemerisConfig()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	e := &Ephemeris{
+		cfg:     cfg,
+		calls:   make(chan func()),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	ready := make(chan struct{})
+	go e.run(ready)
+	<-ready
+
+	return e
+}
+
+// run executes calls on a locked OS thread until the Ephemeris is closed.
+// The thread is never unlocked, so it exits together with the goroutine and
+// its thread-local ephemeris state is never shared with other goroutines.
+func (e *Ephemeris) run(ready chan<- struct{}) {
+	runtime.LockOSThread()
+	defer close(e.stopped)
+
+	// The ephemeris path and JPL file reset open files and cached data,
+	// so they are only applied once when the thread starts.
+	if e.cfg.jplFile != "" {
+		SetJplFile(e.cfg.jplFile)
+	}
+	SetEphePath(e.cfg.ephePath)
+	close(ready)
+
+	for {
+		select {
+		case fn := <-e.calls:
+			e.apply()
+			fn()
+		case <-e.done:
+			Close()
+			return
+		}
+	}
+}
+
+// apply sets the per-call settings on the current thread
+func (e *Ephemeris) apply() {
+	SetSidMode(e.cfg.sidMode, e.cfg.sidT0, e.cfg.sidAyanT0)
+	if e.cfg.topoSet {
+		SetTopo(e.cfg.topoLon, e.cfg.topoLat, e.cfg.topoAlt)
+	}
+	SetTidAcc(e.cfg.tidAcc)
+	SetDeltaTUserdef(e.cfg.deltaTUser)
+}
+
+// Do runs fn on the Ephemeris thread after applying its settings.
+// Package-level functions called from fn use this handle's configuration.
+// A panic in fn is propagated to the caller.
+func (e *Ephemeris) Do(fn func()) error {
+	var panicVal interface{}
+	finished := make(chan struct{})
+
+	call := func() {
+		defer close(finished)
+		defer func() {
+			panicVal = recover()
+		}()
+		fn()
+	}
+
+	select {
+	case e.calls <- call:
+	case <-e.done:
+		return ErrEphemerisClosed
+	}
+
+	<-finished
+	if panicVal != nil {
+		panic(panicVal)
+	}
+
+	return nil
+}
+
+// Close stops the Ephemeris thread and releases its ephemeris files
+func (e *Ephemeris) Close() {
+	e.closeOnce.Do(func() {
+		close(e.done)
+	})
+	<-e.stopped
+}
+
+// Calc calculates planetary positions for a given Julian day (ephemeris time)
+func (e *Ephemeris) Calc(tjdEt float64, ipl int32, iflag int32) CalcResult {
+	var result CalcResult
+	if err := e.Do(func() { result = Calc(tjdEt, ipl, iflag) }); err != nil {
+		return errCalcResult(err)
+	}
+	return result
+}
+
+// CalcUT calculates planetary positions for a given Julian day (universal time)
+func (e *Ephemeris) CalcUT(tjdUt float64, ipl int32, iflag int32) CalcResult {
+	var result CalcResult
+	if err := e.Do(func() { result = CalcUT(tjdUt, ipl, iflag) }); err != nil {
+		return errCalcResult(err)
+	}
+	return result
+}
+
+// CalcPctr calculates planetocentric positions
+func (e *Ephemeris) CalcPctr(tjdEt float64, ipl int32, iplctr int32, iflag int32) CalcResult {
+	var result CalcResult
+	if err := e.Do(func() { result = CalcPctr(tjdEt, ipl, iplctr, iflag) }); err != nil {
+		return errCalcResult(err)
+	}
+	return result
+}
+
+// Houses calculates house cusps and other points
+func (e *Ephemeris) Houses(tjdUt float64, geolat, geolon float64, hsys byte) HousesResult {
+	var result HousesResult
+	if err := e.Do(func() { result = Houses(tjdUt, geolat, geolon, hsys) }); err != nil {
+		return HousesResult{Flag: ERR, Error: err.Error()}
+	}
+	return result
+}
+
+// HousesEx calculates house cusps with extended options
+func (e *Ephemeris) HousesEx(tjdUt float64, iflag int32, geolat, geolon float64, hsys byte) HousesResult {
+	var result HousesResult
+	if err := e.Do(func() { result = HousesEx(tjdUt, iflag, geolat, geolon, hsys) }); err != nil {
+		return HousesResult{Flag: ERR, Error: err.Error()}
+	}
+	return result
+}
+
+// HousesEx2 calculates house cusps with extended options (version 2)
+func (e *Ephemeris) HousesEx2(tjdUt float64, iflag int32, geolat, geolon float64, hsys byte) HousesResult {
+	var result HousesResult
+	if err := e.Do(func() { result = HousesEx2(tjdUt, iflag, geolat, geolon, hsys) }); err != nil {
+		return HousesResult{Flag: ERR, Error: err.Error()}
+	}
+	return result
+}
+
+// GetAyanamsaUT calculates the ayanamsa for a given Julian day (universal time)
+func (e *Ephemeris) GetAyanamsaUT(tjdUt float64) (float64, error) {
+	var ayanamsa float64
+	if err := e.Do(func() { ayanamsa = GetAyanamsaUT(tjdUt) }); err != nil {
+		return 0, err
+	}
+	return ayanamsa, nil
+}
+
+// GetAyanamsaExUT calculates the ayanamsa with extended information (universal time)
+func (e *Ephemeris) GetAyanamsaExUT(tjdUt float64, iflag int32) CalcResult {
+	var result CalcResult
+	if err := e.Do(func() { result = GetAyanamsaExUT(tjdUt, iflag) }); err != nil {
+		return errCalcResult(err)
+	}
+	return result
+}
+
+// FixstarUT calculates fixed star positions (universal time)
+func (e *Ephemeris) FixstarUT(star string, tjdUt float64, iflag int32) FixstarResult {
+	var result FixstarResult
+	if err := e.Do(func() { result = FixstarUT(star, tjdUt, iflag) }); err != nil {
+		return FixstarResult{Flag: ERR, StarName: star, Error: err.Error()}
+	}
+	return result
+}
+
+// NodApsUT calculates nodes and apsides (universal time)
+func (e *Ephemeris) NodApsUT(tjdUt float64, ipl int32, iflag int32, method int32) NodApsResult {
+	var result NodApsResult
+	if err := e.Do(func() { result = NodApsUT(tjdUt, ipl, iflag, method) }); err != nil {
+		return NodApsResult{Flag: ERR, Error: err.Error()}
+	}
+	return result
+}
+
+// PhenoUT calculates planetary phenomena (universal time)
+func (e *Ephemeris) PhenoUT(tjdUt float64, ipl int32, iflag int32) CalcResult {
+	var result CalcResult
+	if err := e.Do(func() { result = PhenoUT(tjdUt, ipl, iflag) }); err != nil {
+		return errCalcResult(err)
+	}
+	return result
+}
+
+// RiseTrans calculates rise, set, and transit times
+func (e *Ephemeris) RiseTrans(tjdUt float64, ipl int32, starname string, epheflag int32, rsmi int32, geopos [3]float64, atpress float64, attemp float64) RiseTransResult {
+	var result RiseTransResult
+	if err := e.Do(func() {
+		result = RiseTrans(tjdUt, ipl, starname, epheflag, rsmi, geopos, atpress, attemp)
+	}); err != nil {
+		return RiseTransResult{Flag: ERR, Error: err.Error()}
+	}
+	return result
+}
+
+// errCalcResult returns a failed CalcResult carrying err
+func errCalcResult(err error) CalcResult {
+	return CalcResult{
+		Flag:  ERR,
+		Error: err.Error(),
+		Data:  make([]float64, 6),
+	}
+}
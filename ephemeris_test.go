@@ -0,0 +1,115 @@
+// Go Swiss Ephemeris - Ephemeris Handle Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestEphemeris_ConcurrentSidModes(t *testing.T) {
+	lahiri := NewEphemeris(WithSidMode(SidmLahiri, 0, 0))
+	defer lahiri.Close()
+	fagan := NewEphemeris(WithSidMode(SidmFaganBradley, 0, 0))
+	defer fagan.Close()
+
+	jd := Julday(2000, 1, 1, 12.0, GregCal)
+	ayanLahiri, err := lahiri.GetAyanamsaUT(jd)
+	if err != nil {
+		t.Fatalf("GetAyanamsaUT failed: %v", err)
+	}
+	ayanFagan, err := fagan.GetAyanamsaUT(jd)
+	if err != nil {
+		t.Fatalf("GetAyanamsaUT failed: %v", err)
+	}
+	if math.Abs(ayanLahiri-ayanFagan) < 0.1 {
+		t.Fatalf("Ayanamsas should differ: Lahiri %.6f, Fagan/Bradley %.6f", ayanLahiri, ayanFagan)
+	}
+
+	want := map[*Ephemeris]float64{
+		lahiri: lahiri.CalcUT(jd, Sun, FlagMoseph|FlagSidereal).Data[0],
+		fagan:  fagan.CalcUT(jd, Sun, FlagMoseph|FlagSidereal).Data[0],
+	}
+
+	// Sidereal positions are free of nutation, so allow a small tolerance
+	if diff := math.Abs(Difdeg2n(want[fagan], want[lahiri]) - (ayanLahiri - ayanFagan)); diff > 0.01 {
+		t.Errorf("Sidereal Sun difference does not match ayanamsa difference (off by %.6f°)", diff)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		for eph, expected := range want {
+			wg.Add(1)
+			go func(eph *Ephemeris, expected float64) {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					result := eph.CalcUT(jd, Sun, FlagMoseph|FlagSidereal)
+					if result.Flag < 0 {
+						t.Errorf("CalcUT failed: %s", result.Error)
+						return
+					}
+					if math.Abs(result.Data[0]-expected) > 1e-6 {
+						t.Errorf("Sidereal Sun = %.6f°, expected %.6f°", result.Data[0], expected)
+						return
+					}
+				}
+			}(eph, expected)
+		}
+	}
+	wg.Wait()
+}
+
+func TestEphemeris_ReappliesSettings(t *testing.T) {
+	eph := NewEphemeris(WithSidMode(SidmLahiri, 0, 0))
+	defer eph.Close()
+
+	jd := Julday(2000, 1, 1, 12.0, GregCal)
+	before, _ := eph.GetAyanamsaUT(jd)
+
+	// Changing the mode from inside Do must not leak into later calls
+	if err := eph.Do(func() { SetSidMode(SidmRaman, 0, 0) }); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+
+	after, _ := eph.GetAyanamsaUT(jd)
+	if before != after {
+		t.Errorf("Ayanamsa changed from %.6f to %.6f", before, after)
+	}
+}
+
+func TestEphemeris_Closed(t *testing.T) {
+	eph := NewEphemeris()
+	eph.Close()
+	eph.Close()
+
+	if err := eph.Do(func() {}); !errors.Is(err, ErrEphemerisClosed) {
+		t.Errorf("Do after Close returned %v, expected ErrEphemerisClosed", err)
+	}
+
+	result := eph.CalcUT(2451545.0, Sun, FlagMoseph)
+	if result.Flag != ERR {
+		t.Errorf("CalcUT after Close returned flag %d, expected ERR", result.Flag)
+	}
+
+	houses := eph.Houses(2451545.0, 51.5, 0, 'P')
+	if houses.Flag != ERR || houses.Error != ErrEphemerisClosed.Error() {
+		t.Errorf("Houses after Close returned flag %d and error %q", houses.Flag, houses.Error)
+	}
+}
@@ -0,0 +1,212 @@
+// Go Swiss Ephemeris - Errors
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Sentinel errors recognized in Swiss Ephemeris error messages.
+// Use errors.Is to test an error returned by this package against them.
+var (
+	ErrEphemerisFileNotFound = errors.New("swisseph: ephemeris file not found")
+	ErrDateOutOfRange        = errors.New("swisseph: date out of range")
+	ErrStarNotFound          = errors.New("swisseph: star not found")
+	ErrCircumpolar           = errors.New("swisseph: body is circumpolar")
+	ErrInvalidDate           = errors.New("swisseph: invalid date")
+
+	// ErrEphemerisFallback is a warning: the calculation succeeded, but with
+	// another ephemeris than the requested one.
+	ErrEphemerisFallback = errors.New("swisseph: fell back to another ephemeris")
+
+	// ErrFallbackToMoshier is a warning: the calculation succeeded, but with
+	// the Moshier ephemeris instead of the requested Swiss or JPL ephemeris.
+	ErrFallbackToMoshier = errors.New("swisseph: fell back to Moshier ephemeris")
+)
+
+// Error is an error or warning reported by a Swiss Ephemeris function
+type Error struct {
+	Func    string // Swiss Ephemeris C function name
+	Flag    int32  // Return flag
+	Message string // Error message from the C library
+	Warning bool   // True if the calculation succeeded and its result is usable
+	kinds   []error
+}
+
+// Error returns the message reported by the C library
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if len(e.kinds) > 0 {
+		return e.kinds[0].Error()
+	}
+	return "swisseph: " + e.Func + " failed"
+}
+
+// Unwrap returns the sentinel errors matched by this error
+func (e *Error) Unwrap() []error {
+	return e.kinds
+}
+
+// IsWarning reports whether err only carries a warning, meaning the result
+// returned together with it is valid
+func IsWarning(err error) bool {
+	var seErr *Error
+	return errors.As(err, &seErr) && seErr.Warning
+}
+
+// errorPatterns maps messages of the C library to sentinel errors
+var errorPatterns = []struct {
+	re   *regexp.Regexp
+	kind error
+}{
+	{regexp.MustCompile(`(?i)file\b.*\bnot found`), ErrEphemerisFileNotFound},
+	{regexp.MustCompile(`(?i)\boutside\b.*\brange\b|lower limit|upper limit`), ErrDateOutOfRange},
+	{regexp.MustCompile(`(?i)\bstar\b.*\bnot found|could not find star|did not match|fixed star number .* not available`), ErrStarNotFound},
+	{regexp.MustCompile(`(?i)circumpolar`), ErrCircumpolar},
+	{regexp.MustCompile(`(?i)invalid (date|time)`), ErrInvalidDate},
+	{regexp.MustCompile(`(?i)using moshier`), ErrFallbackToMoshier},
+}
+
+// classifyError returns the sentinel errors matching a C error message
+func classifyError(serr string) []error {
+	var kinds []error
+	for _, p := range errorPatterns {
+		if p.re.MatchString(serr) {
+			kinds = append(kinds, p.kind)
+		}
+	}
+	return kinds
+}
+
+func containsError(kinds []error, kind error) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// newError returns an *Error if the call failed or the C library left a
+// message in serr, and nil otherwise
+func newError(fn string, flag int32, serr string, failed bool) error {
+	if !failed && serr == "" {
+		return nil
+	}
+
+	return &Error{
+		Func:    fn,
+		Flag:    flag,
+		Message: strings.TrimSpace(serr),
+		Warning: !failed,
+		kinds:   classifyError(serr),
+	}
+}
+
+// calcError is newError for functions returning ephemeris flags; it also
+// reports a fallback to another ephemeris that the C library did not mention
+// in serr
+func calcError(fn string, iflag int32, retflag int32, serr string) error {
+	err := newError(fn, retflag, serr, retflag < 0)
+	fb := ephemerisFallback(iflag, retflag, serr)
+	if fb == nil {
+		return err
+	}
+
+	if err == nil {
+		err = &Error{Func: fn, Flag: retflag, Warning: true}
+	}
+	seErr := err.(*Error)
+	for _, kind := range fb.kinds() {
+		if !containsError(seErr.kinds, kind) {
+			seErr.kinds = append(seErr.kinds, kind)
+		}
+	}
+	return seErr
+}
+
+// ephemerisBits returns the ephemeris selected by a flag, or 0 if none
+func ephemerisBits(flag int32) int32 {
+	return flag & (FlagJpleph | FlagSwieph | FlagMoseph)
+}
+
+// ephemerisFallback compares the requested and the returned ephemeris flags
+// and returns nil if the requested ephemeris was used
+func ephemerisFallback(iflag int32, retflag int32, serr string) *EphemerisFallback {
+	if retflag < 0 {
+		return nil
+	}
+
+	requested := ephemerisBits(iflag)
+	if requested == 0 {
+		requested = FlagDefaulteph
+	}
+	used := ephemerisBits(retflag)
+	if used == 0 || used == requested {
+		return nil
+	}
+
+	return &EphemerisFallback{
+		Requested: requested,
+		Used:      used,
+		Reason:    strings.TrimSpace(serr),
+	}
+}
+
+// ephemerisName returns a readable name for an ephemeris flag
+func ephemerisName(flag int32) string {
+	switch flag {
+	case FlagJpleph:
+		return "JPL"
+	case FlagSwieph:
+		return "Swiss Ephemeris"
+	case FlagMoseph:
+		return "Moshier"
+	}
+	return "unknown"
+}
+
+// String describes the fallback
+func (f *EphemerisFallback) String() string {
+	s := ephemerisName(f.Requested) + " requested, " + ephemerisName(f.Used) + " used"
+	if f.Reason != "" {
+		s += ": " + f.Reason
+	}
+	return s
+}
+
+// Err returns the fallback as a warning error matching ErrEphemerisFallback,
+// and ErrFallbackToMoshier if Moshier was used. It returns nil for a nil
+// fallback, so result.Fallback.Err() can be used to make fallbacks fatal.
+func (f *EphemerisFallback) Err() error {
+	if f == nil {
+		return nil
+	}
+	return &Error{Func: "ephemeris", Flag: f.Used, Message: f.String(), Warning: true, kinds: f.kinds()}
+}
+
+func (f *EphemerisFallback) kinds() []error {
+	if f.Used == FlagMoseph {
+		return []error{ErrEphemerisFallback, ErrFallbackToMoshier}
+	}
+	return []error{ErrEphemerisFallback}
+}
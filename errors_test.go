@@ -0,0 +1,128 @@
+// Go Swiss Ephemeris - Error Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		serr string
+		want []error
+	}{
+		{"SwissEph file 'sepl_18.se1' not found in PATH '.:/users/ephe/' \nusing Moshier eph.; ",
+			[]error{ErrEphemerisFileNotFound, ErrFallbackToMoshier}},
+		{"jd 9999999.000000 outside JPL eph. range 625360.50 .. 2816912.50;", []error{ErrDateOutOfRange}},
+		{"jd 0.000000 < lower limit 625307.500000;", []error{ErrDateOutOfRange}},
+		{"star xyz not found", []error{ErrStarNotFound}},
+		{"error, swe_fixstar(): could not find star name xyz", []error{ErrStarNotFound}},
+		{"Sirius is circumpolar, cannot calculate heliacal event", []error{ErrCircumpolar}},
+		{"invalid date: year = 2000, month = 13, day = 1", []error{ErrInvalidDate}},
+	}
+
+	for _, test := range tests {
+		err := newError("swe_test", ERR, test.serr, true)
+		for _, want := range test.want {
+			if !errors.Is(err, want) {
+				t.Errorf("%q: expected errors.Is(err, %v)", test.serr, want)
+			}
+		}
+		if got := len(err.(*Error).Unwrap()); got != len(test.want) {
+			t.Errorf("%q: matched %d sentinel errors, expected %d", test.serr, got, len(test.want))
+		}
+	}
+}
+
+func TestCalcUTE(t *testing.T) {
+	jd := Julday(2000, 1, 1, 12.0, GregCal)
+
+	result, err := CalcUTE(jd, Sun, FlagMoseph)
+	if err != nil {
+		t.Fatalf("CalcUTE with Moshier returned %v", err)
+	}
+	if result.Data[0] < 270 || result.Data[0] > 290 {
+		t.Errorf("Sun position unexpected: %.2f°", result.Data[0])
+	}
+
+	// Without ephemeris files the Swiss Ephemeris falls back to Moshier
+	if getEphePath() == "" {
+		eph := NewEphemeris(WithEphePath("/nonexistent"))
+		defer eph.Close()
+
+		if doErr := eph.Do(func() { _, err = CalcUTE(jd, Sun, FlagSwieph) }); doErr != nil {
+			t.Fatalf("Do failed: %v", doErr)
+		}
+		if !errors.Is(err, ErrFallbackToMoshier) {
+			t.Errorf("Expected ErrFallbackToMoshier, got %v", err)
+		}
+		if !IsWarning(err) {
+			t.Errorf("Fallback to Moshier should be a warning: %v", err)
+		}
+	}
+}
+
+func TestUtcToJdInvalidDate(t *testing.T) {
+	_, err := UtcToJd(2000, 13, 1, 12, 0, 0, GregCal)
+	if !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("Expected ErrInvalidDate, got %v", err)
+	}
+	if IsWarning(err) {
+		t.Error("Invalid date should not be a warning")
+	}
+}
+
+func TestRiseTransECircumpolar(t *testing.T) {
+	// The Sun does not set at 80°N in June
+	jd := Julday(2024, 6, 1, 0.0, GregCal)
+	geopos := [3]float64{15.0, 80.0, 0}
+
+	_, err := RiseTransE(jd, Sun, "", FlagMoseph, CalcSet, geopos, 1013.25, 10.0)
+	if !errors.Is(err, ErrCircumpolar) {
+		t.Errorf("Expected ErrCircumpolar, got %v", err)
+	}
+}
+
+func TestCalcUTFallback(t *testing.T) {
+	if getEphePath() != "" {
+		t.Skip("Ephemeris files found, no fallback to test")
+	}
+	eph := NewEphemeris(WithEphePath("/nonexistent"))
+	defer eph.Close()
+
+	jd := Julday(2000, 1, 1, 12.0, GregCal)
+
+	result := eph.CalcUT(jd, Mars, FlagMoseph)
+	if result.Fallback != nil {
+		t.Errorf("Unexpected fallback for Moshier request: %s", result.Fallback)
+	}
+
+	result = eph.CalcUT(jd, Mars, FlagSwieph)
+	if result.Fallback == nil {
+		t.Fatal("Expected a fallback to Moshier")
+	}
+	if result.Fallback.Requested != FlagSwieph || result.Fallback.Used != FlagMoseph {
+		t.Errorf("Fallback requested %d used %d, expected %d and %d",
+			result.Fallback.Requested, result.Fallback.Used, FlagSwieph, FlagMoseph)
+	}
+	if err := result.Fallback.Err(); !errors.Is(err, ErrFallbackToMoshier) || !errors.Is(err, ErrEphemerisFallback) {
+		t.Errorf("Fallback error should match ErrFallbackToMoshier and ErrEphemerisFallback: %v", err)
+	}
+	t.Logf("Fallback: %s", result.Fallback)
+}
@@ -37,13 +37,11 @@ func main() {
 
 	// Use current date/time
 	now := time.Now().UTC()
-	jd := swisseph.Julday(
-		int32(now.Year()),
-		int32(now.Month()),
-		int32(now.Day()),
-		float64(now.Hour())+float64(now.Minute())/60.0+float64(now.Second())/3600.0,
-		swisseph.GregCal,
-	)
+	jd, _, err := swisseph.JDFromTime(now)
+	if err != nil {
+		fmt.Printf("Error converting date: %v\n", err)
+		return
+	}
 
 	fmt.Printf("Current Date: %s\n", now.Format("2006-01-02 15:04:05 UTC"))
 	fmt.Printf("Julian Day: %.6f\n\n", jd)
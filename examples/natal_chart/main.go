@@ -45,121 +45,58 @@ func main() {
 	jd := swisseph.Julday(year, month, day, hour, swisseph.GregCal)
 	fmt.Printf("Julian Day: %.6f\n\n", jd)
 
-	// Set topocentric location
-	swisseph.SetTopo(lon, lat, 0)
+	// Build the chart with topocentric positions
+	bodies := append(swisseph.DefaultChartBodies(), swisseph.Chiron)
+	chart, err := swisseph.NewChartJD(jd, [3]float64{lon, lat, 0},
+		swisseph.WithHouseSystem('P'),
+		swisseph.WithTopocentric(),
+		swisseph.WithBodies(bodies...))
+	if err != nil && !swisseph.IsWarning(err) {
+		// Chiron needs the asteroid ephemeris files
+		chart, err = swisseph.NewChartJD(jd, [3]float64{lon, lat, 0},
+			swisseph.WithHouseSystem('P'),
+			swisseph.WithTopocentric())
+	}
+	if err != nil && !swisseph.IsWarning(err) {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
 
-	// Calculate house cusps
+	// House cusps
 	fmt.Println("House Cusps (Placidus)")
 	fmt.Println("----------------------")
-	houses := swisseph.Houses(jd, lat, lon, 'P')
-
-	if houses.Flag >= 0 {
-		for i, cusp := range houses.Houses {
-			fmt.Printf("House %2d: %s\n", i+1, formatDegree(cusp))
-		}
-
-		fmt.Printf("\nAngles:\n")
-		fmt.Printf("Ascendant (ASC): %s\n", formatDegree(houses.Points[swisseph.Asc]))
-		fmt.Printf("Midheaven (MC):  %s\n", formatDegree(houses.Points[swisseph.MC]))
-		fmt.Printf("Descendant (DSC): %s\n", formatDegree(swisseph.Degnorm(houses.Points[swisseph.Asc]+180)))
-		fmt.Printf("Imum Coeli (IC):  %s\n", formatDegree(swisseph.Degnorm(houses.Points[swisseph.MC]+180)))
+	for i, cusp := range chart.Cusps {
+		fmt.Printf("House %2d: %s\n", i+1, formatDegree(cusp))
 	}
 
-	// Calculate planetary positions
+	fmt.Printf("\nAngles:\n")
+	fmt.Printf("Ascendant (ASC): %s\n", formatDegree(chart.Angles[swisseph.Asc]))
+	fmt.Printf("Midheaven (MC):  %s\n", formatDegree(chart.Angles[swisseph.MC]))
+	fmt.Printf("Descendant (DSC): %s\n", formatDegree(swisseph.Degnorm(chart.Angles[swisseph.Asc]+180)))
+	fmt.Printf("Imum Coeli (IC):  %s\n", formatDegree(swisseph.Degnorm(chart.Angles[swisseph.MC]+180)))
+
+	// Planetary positions
 	fmt.Println("\nPlanetary Positions")
 	fmt.Println("-------------------")
-
-	planets := []struct {
-		id   int32
-		name string
-	}{
-		{swisseph.Sun, "Sun"},
-		{swisseph.Moon, "Moon"},
-		{swisseph.Mercury, "Mercury"},
-		{swisseph.Venus, "Venus"},
-		{swisseph.Mars, "Mars"},
-		{swisseph.Jupiter, "Jupiter"},
-		{swisseph.Saturn, "Saturn"},
-		{swisseph.Uranus, "Uranus"},
-		{swisseph.Neptune, "Neptune"},
-		{swisseph.Pluto, "Pluto"},
-		{swisseph.TrueNode, "North Node"},
-		{swisseph.Chiron, "Chiron"},
-	}
-
-	for _, planet := range planets {
-		result := swisseph.CalcUT(jd, planet.id, swisseph.FlagSwieph|swisseph.FlagSpeed)
-		if result.Flag >= 0 {
-			longitude := result.Data[0]
-			speedLon := result.Data[3]
-
-			// Determine if retrograde
-			retrograde := ""
-			if speedLon < 0 {
-				retrograde = " (R)"
-			}
-
-			// Find house placement
-			house := findHouse(longitude, houses.Houses)
-
-			fmt.Printf("%-12s: %s%s in House %d\n",
-				planet.name, formatDegree(longitude), retrograde, house)
+	for _, b := range chart.Bodies {
+		retrograde := ""
+		if b.Retrograde {
+			retrograde = " (R)"
 		}
+		fmt.Printf("%-12s: %s%s in House %d\n",
+			b.Name, formatDegree(b.Position.Lon), retrograde, b.House)
 	}
 
-	// Calculate aspects between planets
+	// Aspects between bodies
 	fmt.Println("\nMajor Aspects")
 	fmt.Println("-------------")
-
-	// Store planet positions
-	positions := make(map[string]float64)
-	for _, planet := range planets[:10] { // Only major planets for aspects
-		result := swisseph.CalcUT(jd, planet.id, swisseph.FlagSwieph)
-		if result.Flag >= 0 {
-			positions[planet.name] = result.Data[0]
-		}
-	}
-
-	// Calculate aspects
-	aspects := []struct {
-		name  string
-		angle float64
-		orb   float64
-	}{
-		{"Conjunction", 0, 8},
-		{"Sextile", 60, 6},
-		{"Square", 90, 8},
-		{"Trine", 120, 8},
-		{"Opposition", 180, 8},
-	}
-
-	planetNames := []string{"Sun", "Moon", "Mercury", "Venus", "Mars",
-		"Jupiter", "Saturn", "Uranus", "Neptune", "Pluto"}
-
-	for i := 0; i < len(planetNames); i++ {
-		for j := i + 1; j < len(planetNames); j++ {
-			p1 := planetNames[i]
-			p2 := planetNames[j]
-			pos1 := positions[p1]
-			pos2 := positions[p2]
-
-			diff := swisseph.Difdegn(pos1, pos2)
-			if diff < 0 {
-				diff = -diff
-			}
-
-			for _, aspect := range aspects {
-				aspectDiff := diff - aspect.angle
-				if aspectDiff < 0 {
-					aspectDiff = -aspectDiff
-				}
-
-				if aspectDiff <= aspect.orb {
-					fmt.Printf("%-10s %-12s %-10s (orb: %.2f°)\n",
-						p1, aspect.name, p2, aspectDiff)
-				}
-			}
+	for _, a := range chart.Aspects {
+		motion := "separating"
+		if a.Applying {
+			motion = "applying"
 		}
+		fmt.Printf("%-10s %-12s %-10s (orb: %.2f°, %s)\n",
+			chart.Bodies[a.Body1].Name, a.Aspect.Name, chart.Bodies[a.Body2].Name, a.Orb, motion)
 	}
 
 	// Calculate lunar phase
@@ -188,25 +125,6 @@ func formatDegree(deg float64) string {
 		split.Degree, split.Minute, split.Second, signs[sign], symbols[sign])
 }
 
-func findHouse(longitude float64, houses []float64) int {
-	for i := 0; i < len(houses); i++ {
-		nextHouse := houses[(i+1)%len(houses)]
-		currentHouse := houses[i]
-
-		// Handle wrap-around at 360°
-		if nextHouse < currentHouse {
-			if longitude >= currentHouse || longitude < nextHouse {
-				return i + 1
-			}
-		} else {
-			if longitude >= currentHouse && longitude < nextHouse {
-				return i + 1
-			}
-		}
-	}
-	return 1
-}
-
 func getLunarPhaseName(phase float64) string {
 	if phase < 45 {
 		return "New Moon"
@@ -226,4 +144,3 @@ func getLunarPhaseName(phase float64) string {
 		return "Waning Crescent"
 	}
 }
-
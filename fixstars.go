@@ -55,9 +55,18 @@ func Fixstar(star string, tjdEt float64, iflag int32) FixstarResult {
 		result.Data[i] = float64(xx[i])
 	}
 
+	result.Fallback = ephemerisFallback(iflag, result.Flag, result.Error)
+
 	return result
 }
 
+// FixstarE is Fixstar returning an error for failures, warnings and fallbacks to Moshier.
+// The error matches ErrStarNotFound if the star is not in the star catalogue.
+func FixstarE(star string, tjdEt float64, iflag int32) (FixstarResult, error) {
+	result := Fixstar(star, tjdEt, iflag)
+	return result, calcError("swe_fixstar", iflag, result.Flag, result.Error)
+}
+
 // FixstarUT calculates fixed star positions (universal time)
 func FixstarUT(star string, tjdUt float64, iflag int32) FixstarResult {
 	var xx [6]C.double
@@ -86,9 +95,18 @@ func FixstarUT(star string, tjdUt float64, iflag int32) FixstarResult {
 		result.Data[i] = float64(xx[i])
 	}
 
+	result.Fallback = ephemerisFallback(iflag, result.Flag, result.Error)
+
 	return result
 }
 
+// FixstarUTE is FixstarUT returning an error for failures, warnings and fallbacks to Moshier.
+// The error matches ErrStarNotFound if the star is not in the star catalogue.
+func FixstarUTE(star string, tjdUt float64, iflag int32) (FixstarResult, error) {
+	result := FixstarUT(star, tjdUt, iflag)
+	return result, calcError("swe_fixstar_ut", iflag, result.Flag, result.Error)
+}
+
 // FixstarMag calculates fixed star magnitude
 func FixstarMag(star string) FixstarMagResult {
 	var mag C.double
@@ -112,6 +130,12 @@ func FixstarMag(star string) FixstarMagResult {
 	}
 }
 
+// FixstarMagE is FixstarMag returning an error if the star could not be found
+func FixstarMagE(star string) (FixstarMagResult, error) {
+	result := FixstarMag(star)
+	return result, newError("swe_fixstar_mag", result.Flag, result.Error, result.Flag < 0)
+}
+
 // Fixstar2 calculates fixed star positions using new star file format (ephemeris time)
 func Fixstar2(star string, tjdEt float64, iflag int32) FixstarResult {
 	var xx [6]C.double
@@ -140,9 +164,18 @@ func Fixstar2(star string, tjdEt float64, iflag int32) FixstarResult {
 		result.Data[i] = float64(xx[i])
 	}
 
+	result.Fallback = ephemerisFallback(iflag, result.Flag, result.Error)
+
 	return result
 }
 
+// Fixstar2E is Fixstar2 returning an error for failures, warnings and fallbacks to Moshier.
+// The error matches ErrStarNotFound if the star is not in the star catalogue.
+func Fixstar2E(star string, tjdEt float64, iflag int32) (FixstarResult, error) {
+	result := Fixstar2(star, tjdEt, iflag)
+	return result, calcError("swe_fixstar2", iflag, result.Flag, result.Error)
+}
+
 // Fixstar2UT calculates fixed star positions using new star file format (universal time)
 func Fixstar2UT(star string, tjdUt float64, iflag int32) FixstarResult {
 	var xx [6]C.double
@@ -171,9 +204,18 @@ func Fixstar2UT(star string, tjdUt float64, iflag int32) FixstarResult {
 		result.Data[i] = float64(xx[i])
 	}
 
+	result.Fallback = ephemerisFallback(iflag, result.Flag, result.Error)
+
 	return result
 }
 
+// Fixstar2UTE is Fixstar2UT returning an error for failures, warnings and fallbacks to Moshier.
+// The error matches ErrStarNotFound if the star is not in the star catalogue.
+func Fixstar2UTE(star string, tjdUt float64, iflag int32) (FixstarResult, error) {
+	result := Fixstar2UT(star, tjdUt, iflag)
+	return result, calcError("swe_fixstar2_ut", iflag, result.Flag, result.Error)
+}
+
 // Fixstar2Mag calculates fixed star magnitude using new star file format
 func Fixstar2Mag(star string) FixstarMagResult {
 	var mag C.double
@@ -196,3 +238,9 @@ func Fixstar2Mag(star string) FixstarMagResult {
 		Error:     C.GoString(&serr[0]),
 	}
 }
+
+// Fixstar2MagE is Fixstar2Mag returning an error if the star could not be found
+func Fixstar2MagE(star string) (FixstarMagResult, error) {
+	result := Fixstar2Mag(star)
+	return result, newError("swe_fixstar2_mag", result.Flag, result.Error, result.Flag < 0)
+}
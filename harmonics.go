@@ -0,0 +1,126 @@
+// Go Swiss Ephemeris - Harmonic Charts
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"sort"
+)
+
+// HarmonicChart returns the n-th harmonic of a chart: the longitudes of the
+// bodies, cusps and angles multiplied by n modulo 360°, and their speeds by
+// n. The harmonic may be fractional, such as an age harmonic. The ARMC,
+// latitudes, retrograde states and houses of the bodies are those of the
+// chart, and the aspect grid is recalculated with the aspects of the chart.
+func HarmonicChart(chart *Chart, n float64) *Chart {
+	h := *chart
+	h.Harmonic = n
+	if chart.Harmonic != 0 {
+		h.Harmonic = chart.Harmonic * n
+	}
+
+	h.Bodies = make([]ChartBody, len(chart.Bodies))
+	for i, b := range chart.Bodies {
+		b.Position.Lon = normDeg(b.Position.Lon * n)
+		b.Position.LonSpeed *= n
+		b.Sign = int(b.Position.Lon / 30)
+		b.SignDegree = b.Position.Lon - float64(b.Sign)*30
+		h.Bodies[i] = b
+	}
+
+	h.Cusps = harmonicLons(chart.Cusps, n)
+	h.CuspSpeeds = harmonicSpeeds(chart.CuspSpeeds, n)
+	h.Angles = harmonicLons(chart.Angles, n)
+	h.Angles[ARMC] = chart.Angles[ARMC]
+	h.AngleSpeeds = harmonicSpeeds(chart.AngleSpeeds, n)
+	if h.AngleSpeeds != nil {
+		h.AngleSpeeds[ARMC] = chart.AngleSpeeds[ARMC]
+	}
+
+	points := make([]ChartPoint, len(h.Bodies))
+	for i, b := range h.Bodies {
+		points[i] = b.Point()
+	}
+	h.Aspects = CalcAspects(points, newChartConfig(chart.opts).aspects)
+
+	return &h
+}
+
+func harmonicLons(lons []float64, n float64) []float64 {
+	out := make([]float64, len(lons))
+	for i, lon := range lons {
+		out[i] = normDeg(lon * n)
+	}
+	return out
+}
+
+func harmonicSpeeds(speeds []float64, n float64) []float64 {
+	if speeds == nil {
+		return nil
+	}
+	out := make([]float64, len(speeds))
+	for i, speed := range speeds {
+		out[i] = speed * n
+	}
+	return out
+}
+
+// AgeHarmonic returns the harmonic of an age: the number of the current
+// year of life, fractional, so that the first year is the first harmonic
+func AgeHarmonic(natalJD, targetJD float64) float64 {
+	return (targetJD-natalJD)/TropicalYear + 1
+}
+
+// HarmonicConjunction is a conjunction in a harmonic chart and the aspect
+// in the radix it corresponds to
+type HarmonicConjunction struct {
+	Body1      int     // Index of the first point
+	Body2      int     // Index of the second point
+	Multiple   int     // Multiple k of the aspect k·360°/n
+	RadixAngle float64 // Aspect angle in the radix, k·360°/n
+	Orb        float64 // Distance from the exact conjunction in the harmonic chart
+	RadixOrb   float64 // Distance from the exact aspect in the radix, Orb/n
+}
+
+// HarmonicConjunctions finds the pairs of radix points that are conjunct
+// within orb in the n-th harmonic, closest first. The harmonic may be
+// fractional; the corresponding radix aspects are the multiples of 360°/n up
+// to 180°.
+func HarmonicConjunctions(points []ChartPoint, n, orb float64) []HarmonicConjunction {
+	var conjunctions []HarmonicConjunction
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			sep := math.Abs(angleDiff(points[i].Lon, points[j].Lon))
+			k := math.Round(sep * n / 360)
+			radixOrb := math.Abs(sep - k*360/n)
+			if radixOrb*n > orb {
+				continue
+			}
+			conjunctions = append(conjunctions, HarmonicConjunction{
+				Body1:      i,
+				Body2:      j,
+				Multiple:   int(k),
+				RadixAngle: k * 360 / n,
+				Orb:        radixOrb * n,
+				RadixOrb:   radixOrb,
+			})
+		}
+	}
+	sort.SliceStable(conjunctions, func(a, b int) bool { return conjunctions[a].Orb < conjunctions[b].Orb })
+	return conjunctions
+}
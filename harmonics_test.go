@@ -0,0 +1,89 @@
+// Go Swiss Ephemeris - Harmonic Chart Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHarmonicChart(t *testing.T) {
+	natal := testNatalChart(t)
+
+	h := HarmonicChart(natal, 5)
+	if h.Harmonic != 5 || natal.Harmonic != 0 {
+		t.Errorf("Harmonic %.2f, radix %.2f", h.Harmonic, natal.Harmonic)
+	}
+	for i, b := range h.Bodies {
+		r := natal.Bodies[i]
+		if want := normDeg(r.Position.Lon * 5); math.Abs(b.Position.Lon-want) > 1e-9 {
+			t.Errorf("%s at %.6f, expected %.6f", b.Name, b.Position.Lon, want)
+		}
+		if b.Name != r.Name || b.Retrograde != r.Retrograde || b.House != r.House || b.Position.Lat != r.Position.Lat {
+			t.Errorf("%s: radix data not preserved", b.Name)
+		}
+		if b.Sign != int(b.Position.Lon/30) {
+			t.Errorf("%s: wrong sign %d", b.Name, b.Sign)
+		}
+	}
+	if math.Abs(h.Angles[MC]-normDeg(natal.Angles[MC]*5)) > 1e-9 || h.Angles[ARMC] != natal.Angles[ARMC] {
+		t.Error("Wrong harmonic angles")
+	}
+	if math.Abs(h.Cusps[4]-normDeg(natal.Cusps[4]*5)) > 1e-9 {
+		t.Error("Wrong harmonic cusps")
+	}
+	if natal.Bodies[0].Position.Lon == h.Bodies[0].Position.Lon {
+		t.Error("Radix chart was modified")
+	}
+
+	if h2 := HarmonicChart(h, 2); h2.Harmonic != 10 {
+		t.Errorf("Harmonic of a 5th harmonic chart is %.2f, expected 10", h2.Harmonic)
+	}
+}
+
+func TestHarmonicConjunctions(t *testing.T) {
+	points := []ChartPoint{
+		{Name: "A", Lon: 10},
+		{Name: "B", Lon: 82.5},  // Quintile of A, 0.5° wide
+		{Name: "C", Lon: 226.8}, // Biquintile of B, 0.3° wide, and of A, 0.8° wide
+	}
+
+	conjunctions := HarmonicConjunctions(points, 5, 3)
+	if len(conjunctions) != 2 {
+		t.Fatalf("Unexpected conjunctions %+v", conjunctions)
+	}
+	if c := conjunctions[0]; c.Body1 != 1 || c.Body2 != 2 || c.RadixAngle != 144 || math.Abs(c.Orb-1.5) > 1e-9 {
+		t.Errorf("Unexpected biquintile %+v", c)
+	}
+	c := conjunctions[1]
+	if c.Body1 != 0 || c.Body2 != 1 || c.Multiple != 1 || c.RadixAngle != 72 || math.Abs(c.RadixOrb-0.5) > 1e-9 || math.Abs(c.Orb-2.5) > 1e-9 {
+		t.Errorf("Unexpected quintile %+v", c)
+	}
+
+	// Fractional harmonics: the 2.5th harmonic finds multiples of 144°
+	if conjunctions := HarmonicConjunctions(points, 2.5, 1.5); len(conjunctions) != 1 || conjunctions[0].RadixAngle != 144 {
+		t.Errorf("Unexpected 2.5th harmonic conjunctions %+v", conjunctions)
+	}
+}
+
+func TestAgeHarmonic(t *testing.T) {
+	natal := 2448088.0
+	if h := AgeHarmonic(natal, natal+30.5*TropicalYear); math.Abs(h-31.5) > 1e-9 {
+		t.Errorf("AgeHarmonic at 30.5 years = %.4f, expected 31.5", h)
+	}
+}
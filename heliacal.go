@@ -23,7 +23,6 @@ package swisseph
 // #include "swephexp.h"
 import "C"
 import (
-	"fmt"
 	"unsafe"
 )
 
@@ -81,6 +80,12 @@ func HeliacalUT(tjdstart float64, geopos [3]float64, datm [4]float64, dobs [6]fl
 	return result
 }
 
+// HeliacalUTE is HeliacalUT returning an error if the calculation failed
+func HeliacalUTE(tjdstart float64, geopos [3]float64, datm [4]float64, dobs [6]float64, objectname string, eventType int32, helflag int32) (HeliacalResult, error) {
+	result := HeliacalUT(tjdstart, geopos, datm, dobs, objectname, eventType, helflag)
+	return result, newError("swe_heliacal_ut", result.Flag, result.Error, result.Flag < 0)
+}
+
 // HeliacalPhenoUT calculates heliacal phenomena (universal time)
 func HeliacalPhenoUT(tjdUt float64, geopos [3]float64, datm [4]float64, dobs [6]float64, objectname string, eventType int32, helflag int32) HeliacalResult {
 	var darr [50]C.double
@@ -129,6 +134,12 @@ func HeliacalPhenoUT(tjdUt float64, geopos [3]float64, datm [4]float64, dobs [6]
 	return result
 }
 
+// HeliacalPhenoUTE is HeliacalPhenoUT returning an error if the calculation failed
+func HeliacalPhenoUTE(tjdUt float64, geopos [3]float64, datm [4]float64, dobs [6]float64, objectname string, eventType int32, helflag int32) (HeliacalResult, error) {
+	result := HeliacalPhenoUT(tjdUt, geopos, datm, dobs, objectname, eventType, helflag)
+	return result, newError("swe_heliacal_pheno_ut", result.Flag, result.Error, result.Flag < 0)
+}
+
 // VisLimitMag calculates the limiting visual magnitude
 func VisLimitMag(tjdUt float64, geopos [3]float64, datm [4]float64, dobs [6]float64, objectname string, helflag int32) HeliacalResult {
 	var dret [50]C.double
@@ -176,6 +187,13 @@ func VisLimitMag(tjdUt float64, geopos [3]float64, datm [4]float64, dobs [6]floa
 	return result
 }
 
+// VisLimitMagE is VisLimitMag returning an error if the calculation failed.
+// A return flag of -2 (object below horizon) is not an error.
+func VisLimitMagE(tjdUt float64, geopos [3]float64, datm [4]float64, dobs [6]float64, objectname string, helflag int32) (HeliacalResult, error) {
+	result := VisLimitMag(tjdUt, geopos, datm, dobs, objectname, helflag)
+	return result, newError("swe_vis_limit_mag", result.Flag, result.Error, result.Flag == ERR)
+}
+
 // Solcross calculates when the Sun crosses a specific longitude (ephemeris time)
 func Solcross(x2cross float64, tjdEt float64, flag int32) (float64, error) {
 	var serr [asMaxch]C.char
@@ -189,7 +207,7 @@ func Solcross(x2cross float64, tjdEt float64, flag int32) (float64, error) {
 
 	errStr := C.GoString(&serr[0])
 	if errStr != "" {
-		return float64(jdcross), fmt.Errorf("%s", errStr)
+		return float64(jdcross), newError("swe_solcross", ERR, errStr, true)
 	}
 
 	return float64(jdcross), nil
@@ -208,7 +226,7 @@ func SolcrossUT(x2cross float64, tjdUt float64, flag int32) (float64, error) {
 
 	errStr := C.GoString(&serr[0])
 	if errStr != "" {
-		return float64(jdcross), fmt.Errorf("%s", errStr)
+		return float64(jdcross), newError("swe_solcross_ut", ERR, errStr, true)
 	}
 
 	return float64(jdcross), nil
@@ -227,7 +245,7 @@ func Mooncross(x2cross float64, tjdEt float64, flag int32) (float64, error) {
 
 	errStr := C.GoString(&serr[0])
 	if errStr != "" {
-		return float64(jdcross), fmt.Errorf("%s", errStr)
+		return float64(jdcross), newError("swe_mooncross", ERR, errStr, true)
 	}
 
 	return float64(jdcross), nil
@@ -246,7 +264,7 @@ func MooncrossUT(x2cross float64, tjdUt float64, flag int32) (float64, error) {
 
 	errStr := C.GoString(&serr[0])
 	if errStr != "" {
-		return float64(jdcross), fmt.Errorf("%s", errStr)
+		return float64(jdcross), newError("swe_mooncross_ut", ERR, errStr, true)
 	}
 
 	return float64(jdcross), nil
@@ -267,7 +285,7 @@ func MooncrossNode(tjdEt float64, flag int32) (float64, float64, error) {
 
 	errStr := C.GoString(&serr[0])
 	if errStr != "" {
-		return float64(jdcross), float64(xlon), fmt.Errorf("%s", errStr)
+		return float64(jdcross), float64(xlon), newError("swe_mooncross_node", ERR, errStr, true)
 	}
 
 	return float64(jdcross), float64(xlon), nil
@@ -288,7 +306,7 @@ func MooncrossNodeUT(tjdUt float64, flag int32) (float64, float64, error) {
 
 	errStr := C.GoString(&serr[0])
 	if errStr != "" {
-		return float64(jdcross), float64(xlon), fmt.Errorf("%s", errStr)
+		return float64(jdcross), float64(xlon), newError("swe_mooncross_node_ut", ERR, errStr, true)
 	}
 
 	return float64(jdcross), float64(xlon), nil
@@ -310,7 +328,7 @@ func HelioCross(ipl int32, x2cross float64, tjdEt float64, iflag int32, dir int3
 	)
 
 	if result == ERR {
-		return 0, fmt.Errorf("%s", C.GoString(&serr[0]))
+		return 0, newError("swe_helio_cross", ERR, C.GoString(&serr[0]), true)
 	}
 
 	return float64(jdcross), nil
@@ -332,7 +350,7 @@ func HelioCrossUT(ipl int32, x2cross float64, tjdUt float64, iflag int32, dir in
 	)
 
 	if result == ERR {
-		return 0, fmt.Errorf("%s", C.GoString(&serr[0]))
+		return 0, newError("swe_helio_cross_ut", ERR, C.GoString(&serr[0]), true)
 	}
 
 	return float64(jdcross), nil
@@ -0,0 +1,130 @@
+// Go Swiss Ephemeris - Hindu Lunisolar Calendar
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import "fmt"
+
+// Offsets of the Hindu eras from the Gregorian year in which their year
+// begins with Chaitra
+const (
+	SakaEra   = -78 // Saka year = Gregorian year - 78
+	VikramEra = 57  // Vikram Samvat year = Gregorian year + 57
+)
+
+// MonthScheme selects where the months of the lunisolar calendar begin
+type MonthScheme int
+
+const (
+	Amanta     MonthScheme = iota // Months end at new moon, as in South and West India
+	Purnimanta                    // Months end at full moon, as in North India
+)
+
+// HinduMonthNames returns the names of the 12 lunar months from Chaitra
+func HinduMonthNames() []string {
+	return []string{
+		"Chaitra", "Vaishakha", "Jyeshtha", "Ashadha", "Shravana", "Bhadrapada",
+		"Ashvin", "Kartika", "Margashirsha", "Pausha", "Magha", "Phalguna",
+	}
+}
+
+// HinduDate is a date of the Hindu lunisolar calendar
+type HinduDate struct {
+	SakaYear    int         // Year of the Saka era
+	VikramYear  int         // Year of the Vikram Samvat
+	Month       int         // Lunar month (0 = Chaitra) in the scheme of the date
+	Adhika      bool        // Intercalary month, a lunation without a sankranti
+	Kshaya      bool        // The lunation has two sankrantis and the month after Month is expunged
+	Paksha      Paksha      // Fortnight of the tithi
+	Tithi       int         // Tithi, 0-14 Shukla and 15-29 Krishna paksha
+	Scheme      MonthScheme // Amanta or Purnimanta months
+	NewMoon     float64     // Julian day (UT) of the new moon that began the lunation
+	NextNewMoon float64     // Julian day (UT) of the new moon that ends the lunation
+}
+
+// MonthName returns the name of the month with an Adhika prefix for an
+// intercalary month
+func (d HinduDate) MonthName() string {
+	name := HinduMonthNames()[d.Month]
+	if d.Adhika {
+		name = "Adhika " + name
+	}
+	return name
+}
+
+// String returns the date as in "Adhika Shravana Shukla Saptami, Saka 1945"
+func (d HinduDate) String() string {
+	tithi := TithiName(d.Tithi)
+	return fmt.Sprintf("%s %s, Saka %d", d.MonthName(), tithi, d.SakaYear)
+}
+
+// HinduDateAt returns the date of the Hindu lunisolar calendar at tjdUt;
+// pass the time of sunrise (see Panchanga) for the tithi of a civil day.
+// Julday converts Gregorian and Julian calendar dates to tjdUt.
+//
+// The lunations run from new moon to new moon, found from the elongation of
+// the Moon from the Sun. A lunation is named after the sidereal sign the Sun
+// enters during it in the zodiac of sidMode (SidmLahiri, ...), so that
+// Chaitra contains the sankranti of Mesha. A lunation without a sankranti
+// is an adhika month named after the month that follows it; a lunation with
+// two sankrantis takes the name of the first and the month of the second is
+// kshaya. With Purnimanta months the Krishna paksha belongs to the month
+// after the Amanta month, except in an adhika month, which always runs from
+// new moon to new moon. The years begin with Chaitra Shukla Pratipada.
+// The flags select the ephemeris and default to FlagSwieph.
+func HinduDateAt(tjdUt float64, sidMode int32, flags int32, scheme MonthScheme) (HinduDate, error) {
+	c, unlock := lockSidereal(sidMode, flags)
+	defer unlock()
+
+	elong, _, err := c.elongation(tjdUt)
+	if err != nil {
+		return HinduDate{}, err
+	}
+
+	d := HinduDate{Tithi: int(elong/TithiSpan) % 30, Scheme: scheme}
+	d.Paksha = Paksha(d.Tithi / 15)
+
+	d.NewMoon, d.NextNewMoon, err = spanAround(tjdUt, SynodicMonth+1, searchStep(Moon), c.elongation, 0, 0)
+	if err != nil {
+		return HinduDate{}, err
+	}
+	sun0, _, err := c.lon(d.NewMoon, Sun)
+	if err != nil {
+		return HinduDate{}, err
+	}
+	sun1, _, err := c.lon(d.NextNewMoon, Sun)
+	if err != nil {
+		return HinduDate{}, err
+	}
+
+	sign0, sign1 := int(sun0/30), int(sun1/30)
+	d.Month = (sign0 + 1) % 12
+	d.Adhika = sign0 == sign1
+	d.Kshaya = (sign1-sign0+12)%12 == 2
+
+	// The sankranti of the month falls Month sidereal months after the
+	// Mesha sankranti of the year, in April of its Gregorian year
+	mid := (d.NewMoon + d.NextNewMoon) / 2
+	year := TimeFromJD(mid - float64(d.Month)*SiderealYear/12).Year()
+	d.SakaYear, d.VikramYear = year+SakaEra, year+VikramEra
+
+	if scheme == Purnimanta && d.Paksha == KrishnaPaksha && !d.Adhika {
+		d.Month = (d.Month + 1) % 12
+	}
+
+	return d, c.warn
+}
@@ -0,0 +1,56 @@
+// Go Swiss Ephemeris - Hindu Lunisolar Calendar Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import "testing"
+
+func TestHinduDateAt(t *testing.T) {
+
+	tests := []struct {
+		name             string
+		year, month, day int32
+		hour             float64
+		scheme           MonthScheme
+		expected         string
+		vikram           int
+		adhika, kshaya   bool
+	}{
+		{"Diwali", 2023, 11, 12, 14, Amanta, "Ashvin Amavasya, Saka 1945", 2080, false, false},
+		{"Diwali", 2023, 11, 12, 14, Purnimanta, "Kartika Amavasya, Saka 1945", 2080, false, false},
+		{"Adhika Shravana", 2023, 7, 25, 12, Amanta, "Adhika Shravana Shukla Ashtami, Saka 1945", 2080, true, false},
+		{"Ugadi", 2024, 4, 9, 12, Amanta, "Chaitra Shukla Pratipada, Saka 1946", 2081, false, false},
+		{"before Ugadi", 2024, 4, 5, 6, Amanta, "Phalguna Krishna Ekadashi, Saka 1945", 2080, false, false},
+		{"before Ugadi", 2024, 4, 5, 6, Purnimanta, "Chaitra Krishna Ekadashi, Saka 1945", 2080, false, false},
+		{"Kartika with kshaya Margashirsha", 1963, 11, 29, 0, Amanta, "Kartika Shukla Trayodashi, Saka 1885", 2020, false, true},
+		{"Adhika Chaitra", 1964, 3, 25, 12, Amanta, "Adhika Chaitra Shukla Trayodashi, Saka 1886", 2021, true, false},
+	}
+	for _, test := range tests {
+		jd := Julday(test.year, test.month, test.day, test.hour, GregCal)
+		d, err := HinduDateAt(jd, SidmLahiri, FlagMoseph, test.scheme)
+		if err != nil {
+			t.Fatalf("HinduDateAt failed: %v", err)
+		}
+		if d.String() != test.expected || d.VikramYear != test.vikram || d.Adhika != test.adhika || d.Kshaya != test.kshaya {
+			t.Errorf("%s: %s, Vikram %d, adhika %v, kshaya %v; expected %s, Vikram %d", test.name,
+				d, d.VikramYear, d.Adhika, d.Kshaya, test.expected, test.vikram)
+		}
+		if !(d.NewMoon <= jd && jd < d.NextNewMoon && d.NextNewMoon-d.NewMoon < 30) {
+			t.Errorf("%s: lunation from %.4f to %.4f", test.name, d.NewMoon, d.NextNewMoon)
+		}
+	}
+}
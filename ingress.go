@@ -0,0 +1,184 @@
+// Go Swiss Ephemeris - Ingresses
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"sort"
+)
+
+// Ingress is the crossing of a longitude boundary by a body
+type Ingress struct {
+	JD        float64   // Julian day (UT) of the crossing
+	Body      int32     // Planet number
+	Boundary  float64   // Longitude crossed
+	Direction Direction // Direct or retrograde crossing
+	From      int       // Index of the segment left
+	To        int       // Index of the segment entered
+}
+
+// SignBoundaries returns the longitudes of the 12 zodiac sign cusps
+func SignBoundaries() []float64 {
+	b := make([]float64, 12)
+	for i := range b {
+		b[i] = float64(i) * 30
+	}
+	return b
+}
+
+// SignNames returns the names of the 12 zodiac signs from Aries
+func SignNames() []string {
+	return []string{"Aries", "Taurus", "Gemini", "Cancer", "Leo", "Virgo",
+		"Libra", "Scorpio", "Sagittarius", "Capricorn", "Aquarius", "Pisces"}
+}
+
+// FindIngresses finds all crossings of the given longitude boundaries by a
+// body between startJD and endJD (UT), in time order. Retrograde re-entries
+// are reported as separate ingresses with Direction Retrograde.
+//
+// Boundaries default to the 12 signs (0°, 30°, ..., 330°). Segment i of the
+// result's From and To fields starts at the i-th boundary in ascending order,
+// so for the default boundaries it is the zodiac sign (0 = Aries). Use
+// FlagSidereal together with SetSidMode for sidereal ingresses.
+//
+// If a calculation fell back to another ephemeris, the ingresses are returned
+// together with a warning error (see IsWarning).
+func FindIngresses(startJD, endJD float64, body int32, flags int32, boundaries []float64) ([]Ingress, error) {
+	if endJD <= startJD {
+		return nil, errInvalidRange
+	}
+	if len(boundaries) == 0 {
+		boundaries = SignBoundaries()
+	}
+
+	bounds := make([]float64, len(boundaries))
+	for i, b := range boundaries {
+		bounds[i] = normDeg(b)
+	}
+	sort.Float64s(bounds)
+
+	c := &calculator{iflag: (flags | FlagSpeed) &^ (FlagXYZ | FlagRadians)}
+	lonAt := func(jd float64) (float64, float64, error) { return c.lon(jd, body) }
+
+	crossings, err := findCrossings(startJD, endJD, searchStep(body), lonAt, bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	ingresses := make([]Ingress, len(crossings))
+	for k, cr := range crossings {
+		i := cr.index
+		prev := (i + len(bounds) - 1) % len(bounds)
+		ingresses[k] = Ingress{JD: cr.jd, Body: body, Boundary: bounds[i], Direction: cr.direction, From: prev, To: i}
+		if cr.direction == Retrograde {
+			ingresses[k].From, ingresses[k].To = i, prev
+		}
+	}
+
+	return ingresses, c.warn
+}
+
+// crossing is a boundary crossing found by findCrossings
+type crossing struct {
+	jd        float64   // Julian day of the crossing
+	index     int       // Index of the boundary crossed
+	direction Direction // Direction of the longitude at the crossing
+}
+
+// findCrossings finds all crossings of the longitude bounds by lonAt between
+// startJD and endJD, in time order
+func findCrossings(startJD, endJD, step float64, lonAt func(float64) (float64, float64, error), bounds []float64) ([]crossing, error) {
+	var crossings []crossing
+	err := scanMonotonic(startJD, endJD, step, lonAt, func(t0, t1, lon0, lon1 float64) error {
+		for i, b := range bounds {
+			d0, d1 := angleDiff(lon0, b), angleDiff(lon1, b)
+			if !angleCrossed(d0, d1) {
+				continue
+			}
+
+			jd, err := findRoot(func(jd float64) (float64, error) {
+				lon, _, err := lonAt(jd)
+				return angleDiff(lon, b), err
+			}, t0, t1, d0, d1)
+			if err != nil {
+				return err
+			}
+
+			cr := crossing{jd: jd, index: i, direction: Direct}
+			if d1 < d0 {
+				cr.direction = Retrograde
+			}
+			crossings = append(crossings, cr)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(crossings, func(i, j int) bool { return crossings[i].jd < crossings[j].jd })
+	return crossings, nil
+}
+
+// scanMonotonic samples a longitude function over [startJD, endJD] and calls
+// segment for consecutive intervals in which the longitude is monotonic.
+// Intervals containing a station (a sign change of the speed) are split at
+// the station.
+func scanMonotonic(startJD, endJD, step float64, lonAt func(float64) (float64, float64, error), segment func(t0, t1, lon0, lon1 float64) error) error {
+	t0 := startJD
+	lon0, speed0, err := lonAt(t0)
+	if err != nil {
+		return err
+	}
+
+	for t0 < endJD {
+		t1 := t0 + step
+		if t1 > endJD {
+			t1 = endJD
+		}
+		lon1, speed1, err := lonAt(t1)
+		if err != nil {
+			return err
+		}
+
+		if (speed0 < 0) != (speed1 < 0) {
+			ts, err := findRoot(func(jd float64) (float64, error) {
+				_, speed, err := lonAt(jd)
+				return speed, err
+			}, t0, t1, speed0, speed1)
+			if err != nil {
+				return err
+			}
+			lonS, _, err := lonAt(ts)
+			if err != nil {
+				return err
+			}
+			if err := segment(t0, ts, lon0, lonS); err != nil {
+				return err
+			}
+			if err := segment(ts, t1, lonS, lon1); err != nil {
+				return err
+			}
+		} else if err := segment(t0, t1, lon0, lon1); err != nil {
+			return err
+		}
+
+		t0, lon0, speed0 = t1, lon1, speed1
+	}
+
+	return nil
+}
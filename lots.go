@@ -0,0 +1,306 @@
+// Go Swiss Ephemeris - Arabic Parts
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import "errors"
+
+// errInvalidLot is returned for a lot formula with an unknown or circular
+// reference to another lot, or an invalid cusp or angle
+var errInvalidLot = errors.New("swisseph: invalid lot formula")
+
+// LotTermKind is the kind of a term of a lot formula
+type LotTermKind int
+
+const (
+	TermBody  LotTermKind = iota // A planet or other body
+	TermCusp                     // A house cusp
+	TermAngle                    // An angle such as the Ascendant or the MC
+	TermLot                      // Another lot
+	TermFixed                    // A fixed ecliptic longitude
+)
+
+// LotTerm is a term of a lot formula
+type LotTerm struct {
+	Kind  LotTermKind // Kind of term
+	Body  int32       // Planet number for TermBody
+	Index int         // House (1-12) for TermCusp, angle (Asc, MC, ...) for TermAngle
+	Lot   string      // Name of the lot for TermLot
+	Lon   float64     // Longitude for TermFixed
+}
+
+// LotBody returns a term for a planet or other body
+func LotBody(body int32) LotTerm {
+	return LotTerm{Kind: TermBody, Body: body}
+}
+
+// LotCusp returns a term for the cusp of a house (1-12)
+func LotCusp(house int) LotTerm {
+	return LotTerm{Kind: TermCusp, Index: house}
+}
+
+// LotAngle returns a term for an angle (Asc, MC, Vertex, ...)
+func LotAngle(angle int) LotTerm {
+	return LotTerm{Kind: TermAngle, Index: angle}
+}
+
+// LotRef returns a term for another lot of the same calculation
+func LotRef(name string) LotTerm {
+	return LotTerm{Kind: TermLot, Lot: name}
+}
+
+// LotFixed returns a term for a fixed ecliptic longitude
+func LotFixed(lon float64) LotTerm {
+	return LotTerm{Kind: TermFixed, Lon: lon}
+}
+
+// Lot is an Arabic part: Base + Add - Subtract, such as Fortune =
+// Asc + Moon - Sun
+type Lot struct {
+	Name     string  // Name of the lot
+	Base     LotTerm // Point the distance is projected from, usually the Ascendant
+	Add      LotTerm // Point the distance is measured to in day charts
+	Subtract LotTerm // Point the distance is measured from in day charts
+	Reverse  bool    // Swap Add and Subtract in night charts
+}
+
+// dayNightLot returns a lot projected from the Ascendant that is reversed
+// at night
+func dayNightLot(name string, add, subtract LotTerm) Lot {
+	return Lot{Name: name, Base: LotAngle(Asc), Add: add, Subtract: subtract, Reverse: true}
+}
+
+// HermeticLots returns the seven lots of Hermes after Paulus Alexandrinus:
+// Fortune, Spirit, Eros, Necessity, Courage, Victory and Nemesis, all reversed
+// at night
+func HermeticLots() []Lot {
+	fortune, spirit := LotRef("Fortune"), LotRef("Spirit")
+	return []Lot{
+		dayNightLot("Fortune", LotBody(Moon), LotBody(Sun)),
+		dayNightLot("Spirit", LotBody(Sun), LotBody(Moon)),
+		dayNightLot("Eros", LotBody(Venus), spirit),
+		dayNightLot("Necessity", fortune, LotBody(Mercury)),
+		dayNightLot("Courage", fortune, LotBody(Mars)),
+		dayNightLot("Victory", LotBody(Jupiter), spirit),
+		dayNightLot("Nemesis", fortune, LotBody(Saturn)),
+	}
+}
+
+// MedievalLots returns common lots of the medieval tradition after Abu
+// Ma'shar and Bonatti
+func MedievalLots() []Lot {
+	asc := LotAngle(Asc)
+	return []Lot{
+		dayNightLot("Father", LotBody(Saturn), LotBody(Sun)),
+		dayNightLot("Mother", LotBody(Moon), LotBody(Venus)),
+		{Name: "Siblings", Base: asc, Add: LotBody(Jupiter), Subtract: LotBody(Saturn)},
+		dayNightLot("Children", LotBody(Saturn), LotBody(Jupiter)),
+		{Name: "Marriage of Men", Base: asc, Add: LotBody(Venus), Subtract: LotBody(Saturn)},
+		{Name: "Marriage of Women", Base: asc, Add: LotBody(Saturn), Subtract: LotBody(Venus)},
+		dayNightLot("Illness", LotBody(Mars), LotBody(Saturn)),
+		{Name: "Death", Base: LotBody(Saturn), Add: LotCusp(8), Subtract: LotBody(Moon)},
+	}
+}
+
+// TraditionalLots returns HermeticLots followed by MedievalLots
+func TraditionalLots() []Lot {
+	return append(HermeticLots(), MedievalLots()...)
+}
+
+// SectMethod decides whether a chart is a day or a night chart
+type SectMethod int
+
+const (
+	SectAltitude SectMethod = iota // The Sun is above the horizon, by its true altitude from Azalt
+	SectHouse                      // The Sun is in houses 7 to 12
+)
+
+// LotOptions configures CalcLots
+type LotOptions struct {
+	Lots []Lot      // Lots to calculate; defaults to TraditionalLots
+	Sect SectMethod // Method deciding the sect of the chart
+}
+
+// LotPosition is a calculated lot
+type LotPosition struct {
+	Name       string  // Name of the lot
+	Lon        float64 // Ecliptic longitude
+	Sign       int     // Zodiac sign (0 = Aries)
+	SignDegree float64 // Degree within the sign
+	House      int     // House of the chart (1-12)
+	HousePos   float64 // Position between the cusps, such as 10.5 for the middle of house 10
+	Reversed   bool    // The night formula was used
+}
+
+// Point returns the lot as a ChartPoint for CalcAspects
+func (l LotPosition) Point() ChartPoint {
+	return ChartPoint{Name: l.Name, Class: ClassPart, Lon: l.Lon}
+}
+
+// IsDayChart reports whether the Sun is above the horizon in a chart
+func IsDayChart(chart *Chart, method SectMethod) (bool, error) {
+	defer chart.lockThread()()
+
+	c := &calculator{iflag: chart.Flags}
+	day, err := isDayChart(chart, method, c)
+	if err != nil {
+		return false, err
+	}
+	return day, c.warn
+}
+
+func isDayChart(chart *Chart, method SectMethod, c *calculator) (bool, error) {
+	if method == SectHouse {
+		sun, ok := chart.body(Sun)
+		if ok {
+			return sun.HousePos >= 7, nil
+		}
+		lon, _, err := c.lon(chart.JD, Sun)
+		if err != nil {
+			return false, err
+		}
+		return cuspHousePos(lon, chart.Cusps) >= 7, nil
+	}
+
+	equ := &calculator{iflag: chart.Flags&^FlagSidereal | FlagEquatorial}
+	xx, err := equ.calc(chart.JD, Sun)
+	if c.warn == nil {
+		c.warn = equ.warn
+	}
+	if err != nil {
+		return false, err
+	}
+	hor := Azalt(chart.JD, Equ2Hor, chart.Geopos, 0, 0, [3]float64{xx[0], xx[1], xx[2]})
+	return hor.Altitude > 0, nil
+}
+
+// CalcLots calculates lots for a chart, in the order of the lots. Lots may
+// refer to bodies that are not in the chart, to the cusps and angles of the
+// chart and to other lots in the list. In night charts the lots marked
+// Reverse use their night formula Base + Subtract - Add.
+func CalcLots(chart *Chart, opts LotOptions) ([]LotPosition, error) {
+	defer chart.lockThread()()
+
+	lots := opts.Lots
+	if len(lots) == 0 {
+		lots = TraditionalLots()
+	}
+
+	c := &calculator{iflag: chart.Flags}
+	day, err := isDayChart(chart, opts.Sect, c)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &lotEvaluator{
+		chart:    chart,
+		c:        c,
+		day:      day,
+		lots:     make(map[string]Lot, len(lots)),
+		values:   make(map[string]float64, len(lots)),
+		visiting: make(map[string]bool),
+	}
+	for _, lot := range lots {
+		e.lots[lot.Name] = lot
+	}
+
+	positions := make([]LotPosition, len(lots))
+	for i, lot := range lots {
+		lon, err := e.lot(lot.Name)
+		if err != nil {
+			return nil, err
+		}
+		p := LotPosition{
+			Name:     lot.Name,
+			Lon:      lon,
+			Sign:     int(lon / 30),
+			HousePos: cuspHousePos(lon, chart.Cusps),
+			Reversed: lot.Reverse && !day,
+		}
+		p.SignDegree = lon - float64(p.Sign)*30
+		p.House = int(p.HousePos)
+		positions[i] = p
+	}
+
+	return positions, c.warn
+}
+
+// lotEvaluator evaluates lots that refer to each other
+type lotEvaluator struct {
+	chart    *Chart
+	c        *calculator
+	day      bool
+	lots     map[string]Lot
+	values   map[string]float64
+	visiting map[string]bool
+}
+
+func (e *lotEvaluator) lot(name string) (float64, error) {
+	if lon, ok := e.values[name]; ok {
+		return lon, nil
+	}
+	lot, ok := e.lots[name]
+	if !ok || e.visiting[name] {
+		return 0, errInvalidLot
+	}
+	e.visiting[name] = true
+	defer delete(e.visiting, name)
+
+	add, subtract := lot.Add, lot.Subtract
+	if lot.Reverse && !e.day {
+		add, subtract = subtract, add
+	}
+
+	var lons [3]float64
+	for i, term := range []LotTerm{lot.Base, add, subtract} {
+		lon, err := e.term(term)
+		if err != nil {
+			return 0, err
+		}
+		lons[i] = lon
+	}
+
+	lon := normDeg(lons[0] + lons[1] - lons[2])
+	e.values[name] = lon
+	return lon, nil
+}
+
+func (e *lotEvaluator) term(t LotTerm) (float64, error) {
+	switch t.Kind {
+	case TermBody:
+		if b, ok := e.chart.body(t.Body); ok {
+			return b.Position.Lon, nil
+		}
+		lon, _, err := e.c.lon(e.chart.JD, t.Body)
+		return lon, err
+	case TermCusp:
+		if t.Index < 1 || t.Index > len(e.chart.Cusps) {
+			return 0, errInvalidLot
+		}
+		return e.chart.Cusps[t.Index-1], nil
+	case TermAngle:
+		if t.Index < 0 || t.Index >= len(e.chart.Angles) || t.Index == ARMC {
+			return 0, errInvalidLot
+		}
+		return e.chart.Angles[t.Index], nil
+	case TermLot:
+		return e.lot(t.Lot)
+	case TermFixed:
+		return normDeg(t.Lon), nil
+	}
+	return 0, errInvalidLot
+}
@@ -0,0 +1,111 @@
+// Go Swiss Ephemeris - Arabic Parts Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalcLots(t *testing.T) {
+	day := testNatalChart(t)
+	night, err := NewChartJD(day.JD-0.5, testChartGeopos, WithChartFlags(FlagMoseph))
+	if err != nil {
+		t.Fatalf("NewChartJD failed: %v", err)
+	}
+
+	for _, test := range []struct {
+		chart *Chart
+		day   bool
+	}{{day, true}, {night, false}} {
+		for _, method := range []SectMethod{SectAltitude, SectHouse} {
+			isDay, err := IsDayChart(test.chart, method)
+			if err != nil {
+				t.Fatalf("IsDayChart failed: %v", err)
+			}
+			if isDay != test.day {
+				t.Errorf("IsDayChart(%d) = %v, expected %v", method, isDay, test.day)
+			}
+		}
+
+		lots, err := CalcLots(test.chart, LotOptions{})
+		if err != nil {
+			t.Fatalf("CalcLots failed: %v", err)
+		}
+		if len(lots) != len(TraditionalLots()) {
+			t.Fatalf("%d lots, expected %d", len(lots), len(TraditionalLots()))
+		}
+
+		asc := test.chart.Angles[Asc]
+		sun, moon, venus := test.chart.Bodies[0].Position.Lon, test.chart.Bodies[1].Position.Lon, test.chart.Bodies[3].Position.Lon
+		fortune, spirit := normDeg(asc+moon-sun), normDeg(asc+sun-moon)
+		eros := normDeg(asc + venus - spirit)
+		if !test.day {
+			fortune, spirit = spirit, fortune
+			eros = normDeg(asc + spirit - venus)
+		}
+		expected := map[string]float64{"Fortune": fortune, "Spirit": spirit, "Eros": eros}
+		for _, lot := range lots {
+			if want, ok := expected[lot.Name]; ok && math.Abs(angleDiff(lot.Lon, want)) > 1e-9 {
+				t.Errorf("%s at %.6f, expected %.6f", lot.Name, lot.Lon, want)
+			}
+			if lot.Name == "Fortune" && lot.Reversed == test.day {
+				t.Errorf("Fortune reversed %v in a day chart %v", lot.Reversed, test.day)
+			}
+			if lot.Name == "Siblings" && lot.Reversed {
+				t.Error("Siblings reversed")
+			}
+			if !inHouse(lot.Lon, test.chart.Cusps, lot.House) || lot.Sign != int(lot.Lon/30) {
+				t.Errorf("%s at %.4f: wrong house %d or sign %d", lot.Name, lot.Lon, lot.House, lot.Sign)
+			}
+		}
+	}
+}
+
+func TestCalcLotsUserFormulas(t *testing.T) {
+	chart := testNatalChart(t)
+
+	lots, err := CalcLots(chart, LotOptions{Lots: []Lot{
+		{Name: "Lilith", Base: LotCusp(10), Add: LotBody(MeanApog), Subtract: LotFixed(30)},
+		{Name: "Fortune", Base: LotAngle(Asc), Add: LotBody(Moon), Subtract: LotBody(Sun), Reverse: true},
+		{Name: "Basis", Base: LotRef("Fortune"), Add: LotAngle(MC), Subtract: LotAngle(Asc)},
+	}})
+	if err != nil {
+		t.Fatalf("CalcLots failed: %v", err)
+	}
+
+	lilith := CalcUT(chart.JD, MeanApog, FlagMoseph)
+	if want := normDeg(chart.Cusps[9] + lilith.Data[0] - 30); math.Abs(angleDiff(lots[0].Lon, want)) > 1e-9 {
+		t.Errorf("Lilith lot at %.6f, expected %.6f", lots[0].Lon, want)
+	}
+	if want := normDeg(lots[1].Lon + chart.Angles[MC] - chart.Angles[Asc]); math.Abs(angleDiff(lots[2].Lon, want)) > 1e-9 {
+		t.Errorf("Basis at %.6f, expected %.6f", lots[2].Lon, want)
+	}
+
+	invalid := [][]Lot{
+		{{Name: "A", Base: LotRef("B")}, {Name: "B", Base: LotRef("A")}},
+		{{Name: "A", Base: LotRef("Unknown")}},
+		{{Name: "A", Base: LotCusp(13)}},
+		{{Name: "A", Base: LotAngle(ARMC)}},
+	}
+	for _, lots := range invalid {
+		if _, err := CalcLots(chart, LotOptions{Lots: lots}); err != errInvalidLot {
+			t.Errorf("CalcLots(%+v) returned %v", lots, err)
+		}
+	}
+}
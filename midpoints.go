@@ -0,0 +1,139 @@
+// Go Swiss Ephemeris - Midpoints
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"sort"
+)
+
+// UranianPlanets returns the eight hypothetical bodies of the Hamburg School,
+// Cupido to Poseidon
+func UranianPlanets() []int32 {
+	return []int32{Cupido, Hades, Zeus, Kronos, Apollon, Admetos, Vulkanus, Poseidon}
+}
+
+// Dial is the size of a midpoint dial in degrees. Longitudes are projected
+// onto a dial modulo its size, so that the 90° dial shows hard aspects as
+// conjunctions.
+type Dial float64
+
+const (
+	Dial360 Dial = 360  // The zodiac
+	Dial90  Dial = 90   // Conjunction, square and opposition
+	Dial45  Dial = 45   // Adds the semisquare and sesquiquadrate
+	Dial22  Dial = 22.5 // Adds the 22.5° series
+)
+
+// Project returns the position of a longitude on the dial
+func (d Dial) Project(lon float64) float64 {
+	return math.Mod(normDeg(lon), float64(d))
+}
+
+// Distance returns the distance between two longitudes on the dial, 0 to
+// half the dial
+func (d Dial) Distance(lon1, lon2 float64) float64 {
+	x := math.Mod(normDeg(lon1-lon2), float64(d))
+	return math.Min(x, float64(d)-x)
+}
+
+// Midpoint is the midpoint of two points on the shorter arc
+type Midpoint struct {
+	Point1  int     // Index of the first point
+	Point2  int     // Index of the second point
+	Lon     float64 // Longitude of the midpoint
+	DialLon float64 // Position of the midpoint on the dial
+}
+
+// Midpoints returns the midpoints of all pairs of points, sorted by their
+// position on the dial
+func Midpoints(points []ChartPoint, dial Dial) []Midpoint {
+	var mids []Midpoint
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			lon := DegMidp(points[i].Lon, points[j].Lon)
+			mids = append(mids, Midpoint{Point1: i, Point2: j, Lon: lon, DialLon: dial.Project(lon)})
+		}
+	}
+	sort.SliceStable(mids, func(a, b int) bool { return mids[a].DialLon < mids[b].DialLon })
+	return mids
+}
+
+// MidpointBranch is a midpoint on the axis of a focal point
+type MidpointBranch struct {
+	Midpoint
+	Orb float64 // Distance of the midpoint from the focal point on the dial
+}
+
+// MidpointTree returns the midpoints of pairs of the other points that lie
+// within orb of the focal point on the dial, closest first
+func MidpointTree(points []ChartPoint, focus int, dial Dial, orb float64) []MidpointBranch {
+	var tree []MidpointBranch
+	for _, m := range Midpoints(points, dial) {
+		if m.Point1 == focus || m.Point2 == focus {
+			continue
+		}
+		if d := dial.Distance(m.Lon, points[focus].Lon); d <= orb {
+			tree = append(tree, MidpointBranch{Midpoint: m, Orb: d})
+		}
+	}
+	sort.SliceStable(tree, func(a, b int) bool { return tree[a].Orb < tree[b].Orb })
+	return tree
+}
+
+// PlanetaryPicture is a planetary picture A + B - C = D of four points
+type PlanetaryPicture struct {
+	A, B, C, D int     // Indices of the points
+	Lon        float64 // Sensitive point A + B - C
+	Orb        float64 // Distance of D from the sensitive point on the dial
+}
+
+// PlanetaryPictures returns the planetary pictures A + B - C = D of four
+// different points within orb on the dial, closest first. As A + B - C = D
+// also reads A + B - D = C and C + D - A = B, each picture is listed once
+// with A < B, C < D and A < C.
+func PlanetaryPictures(points []ChartPoint, dial Dial, orb float64) []PlanetaryPicture {
+	type pair struct {
+		i, j int
+		sum  float64
+	}
+	var pairs []pair
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			pairs = append(pairs, pair{i, j, points[i].Lon + points[j].Lon})
+		}
+	}
+
+	var pictures []PlanetaryPicture
+	for x, p := range pairs {
+		for _, q := range pairs[x+1:] {
+			if q.i <= p.i || q.i == p.j || q.j == p.j {
+				continue
+			}
+			if d := dial.Distance(p.sum, q.sum); d <= orb {
+				pictures = append(pictures, PlanetaryPicture{
+					A: p.i, B: p.j, C: q.i, D: q.j,
+					Lon: normDeg(p.sum - points[q.i].Lon),
+					Orb: d,
+				})
+			}
+		}
+	}
+	sort.SliceStable(pictures, func(a, b int) bool { return pictures[a].Orb < pictures[b].Orb })
+	return pictures
+}
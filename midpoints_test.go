@@ -0,0 +1,137 @@
+// Go Swiss Ephemeris - Midpoint Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDial(t *testing.T) {
+	if got := Dial90.Project(275); got != 5 {
+		t.Errorf("Dial90.Project(275) = %.4f, expected 5", got)
+	}
+	if got := Dial22.Project(-1); math.Abs(got-21.5) > 1e-9 {
+		t.Errorf("Dial22.Project(-1) = %.4f, expected 21.5", got)
+	}
+	// A square is a conjunction on the 90° dial, a semisquare on the 45° dial
+	if got := Dial90.Distance(10, 101); math.Abs(got-1) > 1e-9 {
+		t.Errorf("Dial90.Distance(10, 101) = %.4f, expected 1", got)
+	}
+	if got := Dial45.Distance(44, 1); math.Abs(got-2) > 1e-9 {
+		t.Errorf("Dial45.Distance(44, 1) = %.4f, expected 2", got)
+	}
+	if got := Dial360.Distance(350, 10); math.Abs(got-20) > 1e-9 {
+		t.Errorf("Dial360.Distance(350, 10) = %.4f, expected 20", got)
+	}
+}
+
+func TestDegMidp(t *testing.T) {
+	tests := []struct {
+		x1, x0, expected float64
+	}{
+		{100, 200, 150},
+		{350, 10, 0},
+		{10, 350, 0},
+		{300, 100, 20},
+	}
+	for _, test := range tests {
+		if got := DegMidp(test.x1, test.x0); math.Abs(angleDiff(got, test.expected)) > 1e-9 {
+			t.Errorf("DegMidp(%.0f, %.0f) = %.6f, expected %.0f", test.x1, test.x0, got, test.expected)
+		}
+	}
+	if got := RadMidp(0.1, 2*math.Pi-0.1); math.Abs(math.Remainder(got, 2*math.Pi)) > 1e-9 {
+		t.Errorf("RadMidp across 0 = %.6f, expected 0", got)
+	}
+}
+
+func TestMidpoints(t *testing.T) {
+	points := []ChartPoint{
+		{Name: "A", Lon: 10},
+		{Name: "B", Lon: 350},
+		{Name: "C", Lon: 100},
+		{Name: "D", Lon: 181},
+	}
+
+	mids := Midpoints(points, Dial360)
+	if len(mids) != 6 {
+		t.Fatalf("%d midpoints, expected 6", len(mids))
+	}
+	for i := 1; i < len(mids); i++ {
+		if mids[i].DialLon < mids[i-1].DialLon {
+			t.Fatal("Midpoints are not sorted")
+		}
+	}
+	if mids[0].Point1 != 0 || mids[0].Point2 != 1 || mids[0].Lon != 0 {
+		t.Errorf("First midpoint %+v, expected A/B at 0°", mids[0])
+	}
+
+	// A/B at 0° and B/C at 45° lie on the 90° axis of D at 181° within 1°,
+	// the others do not
+	tree := MidpointTree(points, 3, Dial90, 1)
+	if len(tree) != 1 || tree[0].Point1 != 0 || tree[0].Point2 != 1 || math.Abs(tree[0].Orb-1) > 1e-9 {
+		t.Errorf("Unexpected 90° tree %+v", tree)
+	}
+	tree = MidpointTree(points, 3, Dial45, 1)
+	if len(tree) != 2 || tree[1].Point1 != 1 || tree[1].Point2 != 2 {
+		t.Errorf("Unexpected 45° tree %+v", tree)
+	}
+}
+
+func TestPlanetaryPictures(t *testing.T) {
+	points := []ChartPoint{
+		{Name: "A", Lon: 10},
+		{Name: "B", Lon: 50},
+		{Name: "C", Lon: 20},
+		{Name: "D", Lon: 40.5},
+		{Name: "E", Lon: 200},
+	}
+
+	pictures := PlanetaryPictures(points, Dial360, 1)
+	if len(pictures) != 1 {
+		t.Fatalf("Unexpected pictures %+v", pictures)
+	}
+	p := pictures[0]
+	if p.A != 0 || p.B != 1 || p.C != 2 || p.D != 3 || p.Lon != 40 || math.Abs(p.Orb-0.5) > 1e-9 {
+		t.Errorf("Unexpected picture %+v", p)
+	}
+
+	// On the 90° dial, E at 200° also completes A + B - D at 19.5° by opposition
+	if pictures := PlanetaryPictures(points, Dial90, 1); len(pictures) != 2 {
+		t.Errorf("Unexpected 90° pictures %+v", pictures)
+	}
+}
+
+func TestUranianPlanets(t *testing.T) {
+	chart, err := NewChart(testChartTime, testChartGeopos, WithChartFlags(FlagMoseph),
+		WithBodies(append(DefaultChartBodies(), UranianPlanets()...)...))
+	// Without seorbel.txt the built-in orbital elements are used with a warning
+	if err != nil && !IsWarning(err) {
+		t.Fatalf("NewChart failed: %v", err)
+	}
+	points := chart.Points()
+	if len(points) != len(DefaultChartBodies())+8+2 {
+		t.Fatalf("%d points", len(points))
+	}
+	if name := points[len(DefaultChartBodies())].Name; name != "Cupido" {
+		t.Errorf("First Uranian planet is %s", name)
+	}
+	if len(MidpointTree(points, len(points)-1, Dial90, 1.5)) == 0 {
+		t.Error("Empty midpoint tree for the MC")
+	}
+}
@@ -0,0 +1,184 @@
+// Go Swiss Ephemeris - Lunar Phases
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+)
+
+// LunarPhase is one of the four principal phases of the Moon
+type LunarPhase int
+
+const (
+	NewMoon      LunarPhase = iota // Elongation 0°
+	FirstQuarter                   // Elongation 90°
+	FullMoon                       // Elongation 180°
+	LastQuarter                    // Elongation 270°
+)
+
+// String returns the name of the phase
+func (p LunarPhase) String() string {
+	switch p {
+	case NewMoon:
+		return "new moon"
+	case FirstQuarter:
+		return "first quarter"
+	case FullMoon:
+		return "full moon"
+	case LastQuarter:
+		return "last quarter"
+	}
+	return "unknown"
+}
+
+// Constants of the lunation calendar
+const (
+	SynodicMonth = 29.530588853 // Mean synodic month in days
+
+	// brownLunation1 is the new moon of 1923-01-17 02:41 UT that began
+	// Brown lunation number 1
+	brownLunation1 = 2423436.6115
+
+	// SupermoonDistance is the geocentric distance of the Moon in km below
+	// which a new or full moon is called a supermoon
+	SupermoonDistance = 360000.0
+
+	// MicromoonDistance is the geocentric distance of the Moon in km above
+	// which a new or full moon is called a micromoon
+	MicromoonDistance = 405000.0
+)
+
+// MoonPhase is a principal phase of the Moon
+type MoonPhase struct {
+	JD           float64    // Julian day (UT) of the exact phase
+	Phase        LunarPhase // New moon, first quarter, full moon or last quarter
+	MoonLon      float64    // Tropical longitude of the Moon
+	Sign         int        // Zodiac sign of the Moon (0 = Aries)
+	Lunation     int        // Brown lunation number
+	Illumination float64    // Illuminated fraction of the disc, from PhenoUT
+	Distance     float64    // Geocentric distance of the Moon in km
+	Supermoon    bool       // New or full moon closer than SupermoonDistance
+	Micromoon    bool       // New or full moon farther than MicromoonDistance
+}
+
+// MoonPhases finds all new moons, first quarters, full moons and last
+// quarters between startJD and endJD (UT), in time order.
+//
+// If the Swiss Ephemeris files are not available, the phases are calculated
+// with the Moshier ephemeris and returned together with a warning error
+// (see IsWarning).
+func MoonPhases(startJD, endJD float64) ([]MoonPhase, error) {
+	if endJD <= startJD {
+		return nil, errInvalidRange
+	}
+
+	c := &calculator{iflag: FlagSwieph | FlagSpeed}
+	crossings, err := findCrossings(startJD, endJD, searchStep(Moon), c.elongation, []float64{0, 90, 180, 270})
+	if err != nil {
+		return nil, err
+	}
+
+	phases := make([]MoonPhase, len(crossings))
+	for i, cr := range crossings {
+		moon, err := c.calc(cr.jd, Moon)
+		if err != nil {
+			return nil, err
+		}
+		pheno, err := PhenoUTE(cr.jd, Moon, c.iflag)
+		if err := c.check(err); err != nil {
+			return nil, err
+		}
+
+		p := MoonPhase{
+			JD:           cr.jd,
+			Phase:        LunarPhase(cr.index),
+			MoonLon:      moon[0],
+			Sign:         int(moon[0] / 30),
+			Lunation:     lunationNumber(cr.jd, float64(cr.index)/4),
+			Illumination: pheno.Data[1],
+			Distance:     moon[2] * AunitToKm,
+		}
+		if p.Phase == NewMoon || p.Phase == FullMoon {
+			p.Supermoon = p.Distance < SupermoonDistance
+			p.Micromoon = p.Distance > MicromoonDistance
+		}
+		phases[i] = p
+	}
+
+	return phases, c.warn
+}
+
+// lunationNumber returns the Brown lunation number of a phase that happens
+// a fraction of a synodic month after the new moon of its lunation
+func lunationNumber(jd float64, fraction float64) int {
+	return int(math.Round((jd-brownLunation1)/SynodicMonth-fraction)) + 1
+}
+
+// MoonPhaseInfo describes the phase of the Moon at a moment
+type MoonPhaseInfo struct {
+	Age          float64 // Days since the last new moon
+	Elongation   float64 // Longitude of the Moon minus that of the Sun, in [0, 360)
+	PhaseAngle   float64 // Sun-Moon-Earth angle from PhenoUT
+	Illumination float64 // Illuminated fraction of the disc, from PhenoUT
+	Waxing       bool    // True before full moon
+	Lunation     int     // Brown lunation number
+}
+
+// PhaseAt returns the phase of the Moon at jd (UT)
+func PhaseAt(jd float64) (MoonPhaseInfo, error) {
+	c := &calculator{iflag: FlagSwieph | FlagSpeed}
+
+	elong, _, err := c.elongation(jd)
+	if err != nil {
+		return MoonPhaseInfo{}, err
+	}
+	pheno, err := PhenoUTE(jd, Moon, c.iflag)
+	if err := c.check(err); err != nil {
+		return MoonPhaseInfo{}, err
+	}
+
+	// The last new moon is at most a little more than a synodic month ago
+	crossings, err := findCrossings(jd-SynodicMonth-1, jd, searchStep(Moon), c.elongation, []float64{0})
+	if err != nil {
+		return MoonPhaseInfo{}, err
+	}
+	newMoon := crossings[len(crossings)-1].jd
+
+	return MoonPhaseInfo{
+		Age:          jd - newMoon,
+		Elongation:   elong,
+		PhaseAngle:   pheno.Data[0],
+		Illumination: pheno.Data[1],
+		Waxing:       elong < 180,
+		Lunation:     lunationNumber(newMoon, 0),
+	}, c.warn
+}
+
+// elongation returns the longitude of the Moon minus that of the Sun and its
+// speed
+func (c *calculator) elongation(jd float64) (float64, float64, error) {
+	moon, moonSpeed, err := c.lon(jd, Moon)
+	if err != nil {
+		return 0, 0, err
+	}
+	sun, sunSpeed, err := c.lon(jd, Sun)
+	if err != nil {
+		return 0, 0, err
+	}
+	return normDeg(moon - sun), moonSpeed - sunSpeed, nil
+}
@@ -0,0 +1,99 @@
+// Go Swiss Ephemeris - Lunar Phase Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMoonPhases(t *testing.T) {
+	start := Julday(2024, 1, 1, 0.0, GregCal)
+	end := Julday(2024, 2, 5, 0.0, GregCal)
+
+	phases, err := MoonPhases(start, end)
+	if err != nil && !IsWarning(err) {
+		t.Fatalf("MoonPhases failed: %v", err)
+	}
+
+	// Last quarter on 2024-01-04 03:30 UT, then new moon, first quarter, full
+	// moon and last quarter
+	want := []struct {
+		phase LunarPhase
+		jd    float64
+		sign  int
+	}{
+		{LastQuarter, Julday(2024, 1, 4, 3.5, GregCal), 6},
+		{NewMoon, Julday(2024, 1, 11, 11.95, GregCal), 9},
+		{FirstQuarter, Julday(2024, 1, 18, 3.883, GregCal), 0},
+		{FullMoon, Julday(2024, 1, 25, 17.9, GregCal), 4},
+		{LastQuarter, Julday(2024, 2, 2, 23.3, GregCal), 7},
+	}
+	if len(phases) != len(want) {
+		t.Fatalf("Expected %d phases, got %d", len(want), len(phases))
+	}
+	for i, w := range want {
+		p := phases[i]
+		if p.Phase != w.phase || math.Abs(p.JD-w.jd)*24 > 0.1 || p.Sign != w.sign {
+			t.Errorf("Phase %d: got %s at %.5f in sign %d, expected %s at %.5f in sign %d",
+				i, p.Phase, p.JD, p.Sign, w.phase, w.jd, w.sign)
+		}
+	}
+
+	newMoon, fullMoon := phases[1], phases[3]
+	if newMoon.Lunation != 1250 || fullMoon.Lunation != 1250 || phases[0].Lunation != 1249 {
+		t.Errorf("Unexpected lunation numbers %d, %d, %d", phases[0].Lunation, newMoon.Lunation, fullMoon.Lunation)
+	}
+	if newMoon.Illumination > 0.01 || fullMoon.Illumination < 0.99 {
+		t.Errorf("Unexpected illumination %.4f at new moon, %.4f at full moon", newMoon.Illumination, fullMoon.Illumination)
+	}
+}
+
+func TestMoonPhases_Supermoon(t *testing.T) {
+	// The full moon of 2024-09-18 was at about 357,500 km
+	start := Julday(2024, 9, 15, 0.0, GregCal)
+	phases, err := MoonPhases(start, start+7)
+	if err != nil && !IsWarning(err) {
+		t.Fatalf("MoonPhases failed: %v", err)
+	}
+	if len(phases) != 1 || phases[0].Phase != FullMoon {
+		t.Fatalf("Expected one full moon, got %+v", phases)
+	}
+	if !phases[0].Supermoon || phases[0].Micromoon {
+		t.Errorf("Full moon at %.0f km should be a supermoon", phases[0].Distance)
+	}
+}
+
+func TestPhaseAt(t *testing.T) {
+	newMoon := Julday(2024, 1, 11, 11.95, GregCal)
+	firstQuarter := Julday(2024, 1, 18, 3.883, GregCal)
+
+	info, err := PhaseAt(firstQuarter)
+	if err != nil && !IsWarning(err) {
+		t.Fatalf("PhaseAt failed: %v", err)
+	}
+	if math.Abs(info.Age-(firstQuarter-newMoon)) > 0.01 {
+		t.Errorf("Expected age of %.4f days, got %.4f", firstQuarter-newMoon, info.Age)
+	}
+	if !info.Waxing || info.Lunation != 1250 {
+		t.Errorf("Unexpected phase info %+v", info)
+	}
+	if info.Illumination < 0.45 || info.Illumination > 0.55 || math.Abs(info.PhaseAngle-90) > 3 {
+		t.Errorf("Expected a half moon, got illumination %.4f and phase angle %.4f", info.Illumination, info.PhaseAngle)
+	}
+}
@@ -0,0 +1,207 @@
+// Go Swiss Ephemeris - Nakshatras and Lunar Mansions
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+// NakshatraSpan is the width of one of the 27 nakshatras, 13°20'
+const NakshatraSpan = 360.0 / 27
+
+// Abhijit spans 6°40' to 10°53'20" sidereal Capricorn in the system of 28
+// nakshatras, shortening Uttara Ashadha and Shravana
+const (
+	abhijitStart = 276 + 40.0/60
+	abhijitEnd   = 280 + 53.0/60 + 20.0/3600
+)
+
+// NakshatraNames returns the names of the 27 nakshatras from Ashwini
+func NakshatraNames() []string {
+	return []string{
+		"Ashwini", "Bharani", "Krittika", "Rohini", "Mrigashira", "Ardra",
+		"Punarvasu", "Pushya", "Ashlesha", "Magha", "Purva Phalguni", "Uttara Phalguni",
+		"Hasta", "Chitra", "Swati", "Vishakha", "Anuradha", "Jyeshtha",
+		"Mula", "Purva Ashadha", "Uttara Ashadha", "Shravana", "Dhanishta", "Shatabhisha",
+		"Purva Bhadrapada", "Uttara Bhadrapada", "Revati",
+	}
+}
+
+// vimshottariLords are the lords of the nakshatras from Ashwini, repeating
+// three times
+var vimshottariLords = [9]Graha{GrahaKetu, GrahaVenus, GrahaSun, GrahaMoon, GrahaMars, GrahaRahu, GrahaJupiter, GrahaSaturn, GrahaMercury}
+
+// NakshatraSystem selects 27 equal nakshatras or 28 with Abhijit
+type NakshatraSystem int
+
+const (
+	Nakshatras27 NakshatraSystem = iota // 27 nakshatras of 13°20'
+	Nakshatras28                        // 28 nakshatras with Abhijit
+)
+
+// Nakshatra is the nakshatra of a sidereal longitude
+type Nakshatra struct {
+	Index     int     // Index from 0 = Ashwini; Abhijit is 21 among 28
+	Name      string  // Name of the nakshatra
+	Pada      int     // Quarter of the 27-fold nakshatra (1-4)
+	Lord      Graha   // Vimshottari lord of the 27-fold nakshatra
+	Start     float64 // Longitude at which the nakshatra starts
+	End       float64 // Longitude at which the nakshatra ends
+	Degree    float64 // Degrees traversed within the nakshatra
+	Remaining float64 // Degrees left to the end of the nakshatra
+}
+
+// Fraction returns the part of the nakshatra traversed, 0 to 1
+func (n Nakshatra) Fraction() float64 {
+	return n.Degree / (n.Degree + n.Remaining)
+}
+
+// TimeRemaining returns the days left to the end of the nakshatra at a
+// constant speed in degrees per day
+func (n Nakshatra) TimeRemaining(speed float64) float64 {
+	return n.Remaining / speed
+}
+
+// NakshatraAt returns the nakshatra of a sidereal longitude. Pada and Lord
+// always follow the 27 equal nakshatras.
+func NakshatraAt(lon float64, system NakshatraSystem) Nakshatra {
+	lon = normDeg(lon)
+	i := int(lon / NakshatraSpan)
+	names := NakshatraNames()
+
+	n := Nakshatra{
+		Index: i,
+		Name:  names[i],
+		Pada:  int((lon-float64(i)*NakshatraSpan)/(NakshatraSpan/4)) + 1,
+		Lord:  vimshottariLords[i%9],
+		Start: float64(i) * NakshatraSpan,
+		End:   float64(i+1) * NakshatraSpan,
+	}
+
+	if system == Nakshatras28 && i >= 20 {
+		switch {
+		case lon >= abhijitStart && lon < abhijitEnd:
+			n.Index, n.Name, n.Start, n.End = 21, "Abhijit", abhijitStart, abhijitEnd
+		case i == 20:
+			n.End = abhijitStart
+		default:
+			n.Index++
+			if i == 21 {
+				n.Start = abhijitEnd
+			}
+		}
+	}
+
+	n.Degree = lon - n.Start
+	n.Remaining = n.End - lon
+	return n
+}
+
+// LunarNakshatra is the nakshatra of the Moon with the times at which the
+// Moon enters and leaves it
+type LunarNakshatra struct {
+	Nakshatra
+	Lon     float64 // Sidereal longitude of the Moon
+	Speed   float64 // Speed of the Moon in degrees per day
+	StartJD float64 // Julian day (UT) the Moon entered the nakshatra
+	EndJD   float64 // Julian day (UT) the Moon leaves the nakshatra
+}
+
+// MoonNakshatra returns the nakshatra of the Moon at tjdUt in the sidereal
+// zodiac of sidMode (SidmLahiri, ...). The flags select the ephemeris and
+// default to FlagSwieph.
+func MoonNakshatra(tjdUt float64, sidMode int32, flags int32, system NakshatraSystem) (LunarNakshatra, error) {
+	c, unlock := lockSidereal(sidMode, flags)
+	defer unlock()
+
+	lon, speed, err := c.lon(tjdUt, Moon)
+	if err != nil {
+		return LunarNakshatra{}, err
+	}
+
+	ln := LunarNakshatra{Nakshatra: NakshatraAt(lon, system), Lon: lon, Speed: speed}
+	lonAt := func(jd float64) (float64, float64, error) { return c.lon(jd, Moon) }
+	ln.StartJD, ln.EndJD, err = spanAround(tjdUt, 1.5, searchStep(Moon), lonAt, ln.Start, normDeg(ln.End))
+	if err != nil {
+		return LunarNakshatra{}, err
+	}
+	return ln, c.warn
+}
+
+// LunarMansion is one of the 28 lunar mansions of the Arabic or Chinese
+// tradition
+type LunarMansion struct {
+	Index  int     // Index from 0
+	Name   string  // Name of the mansion
+	Start  float64 // Start of the mansion in degrees
+	Width  float64 // Width of the mansion in degrees
+	Degree float64 // Degrees traversed within the mansion
+}
+
+// arabicMansionNames are the manazil al-qamar from the horns of Aries
+var arabicMansionNames = [28]string{
+	"Al Sharatain", "Al Butain", "Al Thurayya", "Al Dabaran", "Al Haqah", "Al Hanah", "Al Dhira",
+	"Al Nathrah", "Al Tarf", "Al Jabhah", "Al Zubrah", "Al Sarfah", "Al Awwa", "Al Simak",
+	"Al Ghafr", "Al Zubana", "Al Iklil", "Al Qalb", "Al Shaulah", "Al Naaim", "Al Baldah",
+	"Sad al Dhabih", "Sad Bula", "Sad al Suud", "Sad al Akhbiyah", "Al Fargh al Muqaddam", "Al Fargh al Muakhkhar", "Batn al Hut",
+}
+
+// ArabicMansionAt returns the Arabic lunar mansion of a longitude: 28 equal
+// mansions of 12°51'26" from 0° Aries. The tradition counts them from the
+// tropical equinox; pass a sidereal longitude for the sidereal variant.
+func ArabicMansionAt(lon float64) LunarMansion {
+	const width = 360.0 / 28
+	lon = normDeg(lon)
+	i := int(lon / width)
+	return LunarMansion{
+		Index:  i,
+		Name:   arabicMansionNames[i],
+		Start:  float64(i) * width,
+		Width:  width,
+		Degree: lon - float64(i)*width,
+	}
+}
+
+// chineseMansions are the 28 xiu with the J2000 right ascension of their
+// determinative stars in degrees, from Jiao (Spica)
+var chineseMansions = [28]struct {
+	name string
+	ra   float64
+}{
+	{"Jiao (Horn)", 201.298}, {"Kang (Neck)", 213.224}, {"Di (Root)", 222.720},
+	{"Fang (Room)", 239.713}, {"Xin (Heart)", 245.297}, {"Wei (Tail)", 252.968},
+	{"Ji (Winnowing Basket)", 271.452}, {"Dou (Dipper)", 281.414}, {"Niu (Ox)", 305.253},
+	{"Nü (Girl)", 311.919}, {"Xu (Emptiness)", 322.890}, {"Wei (Rooftop)", 331.446},
+	{"Shi (Encampment)", 346.190}, {"Bi (Wall)", 3.309}, {"Kui (Legs)", 14.302},
+	{"Lou (Bond)", 28.660}, {"Wei (Stomach)", 40.863}, {"Mao (Hairy Head)", 56.219},
+	{"Bi (Net)", 67.154}, {"Zi (Turtle Beak)", 83.784}, {"Shen (Three Stars)", 85.190},
+	{"Jing (Well)", 95.740}, {"Gui (Ghost)", 127.899}, {"Liu (Willow)", 129.414},
+	{"Xing (Star)", 141.897}, {"Zhang (Extended Net)", 147.870}, {"Yi (Wings)", 164.944},
+	{"Zhen (Chariot)", 183.952},
+}
+
+// ChineseMansionAt returns the Chinese lunar mansion (xiu) of a right
+// ascension referred to the equinox J2000, as calculated with
+// FlagEquatorial|FlagJ2000. The mansions are unequal and start at the right
+// ascension of their determinative stars.
+func ChineseMansionAt(raJ2000 float64) LunarMansion {
+	ra := normDeg(raJ2000)
+	for i, m := range chineseMansions {
+		width := normDeg(chineseMansions[(i+1)%28].ra - m.ra)
+		if d := normDeg(ra - m.ra); d < width {
+			return LunarMansion{Index: i, Name: m.name, Start: m.ra, Width: width, Degree: d}
+		}
+	}
+	return LunarMansion{}
+}
@@ -0,0 +1,140 @@
+// Go Swiss Ephemeris - Nakshatra Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestNakshatraAt(t *testing.T) {
+	tests := []struct {
+		lon    float64
+		system NakshatraSystem
+		index  int
+		name   string
+		pada   int
+		lord   Graha
+	}{
+		{0, Nakshatras27, 0, "Ashwini", 1, GrahaKetu},
+		{40, Nakshatras27, 3, "Rohini", 1, GrahaMoon},
+		{130, Nakshatras27, 9, "Magha", 4, GrahaKetu},
+		{278, Nakshatras27, 20, "Uttara Ashadha", 4, GrahaSun},
+		{359.9, Nakshatras27, 26, "Revati", 4, GrahaMercury},
+		{272, Nakshatras28, 20, "Uttara Ashadha", 2, GrahaSun},
+		{278, Nakshatras28, 21, "Abhijit", 4, GrahaSun},
+		{285, Nakshatras28, 22, "Shravana", 2, GrahaMoon},
+		{359.9, Nakshatras28, 27, "Revati", 4, GrahaMercury},
+	}
+	for _, test := range tests {
+		n := NakshatraAt(test.lon, test.system)
+		if n.Index != test.index || n.Name != test.name || n.Pada != test.pada || n.Lord != test.lord {
+			t.Errorf("NakshatraAt(%.1f, %d) = %d %s pada %d lord %s, expected %d %s pada %d lord %s",
+				test.lon, test.system, n.Index, n.Name, n.Pada, n.Lord, test.index, test.name, test.pada, test.lord)
+		}
+		if math.Abs(n.Start+n.Degree-test.lon) > 1e-9 || math.Abs(n.End-n.Remaining-test.lon) > 1e-9 {
+			t.Errorf("NakshatraAt(%.1f, %d): wrong span %+v", test.lon, test.system, n)
+		}
+	}
+
+	n := NakshatraAt(280, Nakshatras28)
+	if math.Abs(n.End-n.Start-(abhijitEnd-abhijitStart)) > 1e-9 || math.Abs(n.End-n.Start-4.2222222) > 1e-6 {
+		t.Errorf("Abhijit spans %.6f° to %.6f°", n.Start, n.End)
+	}
+	if n := NakshatraAt(40+NakshatraSpan/2, Nakshatras27); math.Abs(n.Fraction()-0.5) > 1e-9 || math.Abs(n.TimeRemaining(NakshatraSpan)-0.5) > 1e-9 {
+		t.Errorf("Fraction %.4f, time remaining %.4f", n.Fraction(), n.TimeRemaining(NakshatraSpan))
+	}
+}
+
+func TestMoonNakshatra(t *testing.T) {
+	jd := Julday(2024, 1, 1, 0.0, GregCal)
+
+	ln, err := MoonNakshatra(jd, SidmLahiri, FlagMoseph, Nakshatras27)
+	if err != nil {
+		t.Fatalf("MoonNakshatra failed: %v", err)
+	}
+	if ln.StartJD >= jd || ln.EndJD <= jd || ln.EndJD-ln.StartJD < 0.8 || ln.EndJD-ln.StartJD > 1.3 {
+		t.Errorf("Moon in %s from %.4f to %.4f", ln.Name, ln.StartJD, ln.EndJD)
+	}
+
+	// The Moon leaves the nakshatra at its end in the Lahiri zodiac
+	c, unlock := lockSidereal(SidmLahiri, FlagMoseph)
+	defer unlock()
+	lon, _, err := c.lon(ln.EndJD, Moon)
+	if err != nil {
+		t.Fatalf("Calculation failed: %v", err)
+	}
+	if d := angleDiff(lon, ln.End); math.Abs(d) > 1e-5 {
+		t.Errorf("Moon at %.6f when leaving %s, expected %.6f", lon, ln.Name, ln.End)
+	}
+	if ln.Index != NakshatraAt(ln.Lon, Nakshatras27).Index || ln.Speed < 11 {
+		t.Errorf("Unexpected lunar nakshatra %+v", ln)
+	}
+}
+
+func TestMoonNakshatra_ThreadSettings(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	SetSidMode(SidmRaman, 0, 0)
+	defer SetSidMode(SidmFaganBradley, 0, 0)
+
+	before := getThreadSettings()
+	jd := Julday(2024, 1, 1, 0.0, GregCal)
+	if _, err := MoonNakshatra(jd, SidmLahiri, FlagMoseph, Nakshatras27); err != nil {
+		t.Fatalf("MoonNakshatra failed: %v", err)
+	}
+	if _, err := MoonDashas(jd, SidmLahiri, FlagMoseph, DashaOptions{}); err != nil {
+		t.Fatalf("MoonDashas failed: %v", err)
+	}
+	if getThreadSettings() != before {
+		t.Error("Sidereal calculations changed the settings of the calling thread")
+	}
+}
+
+func TestLunarMansions(t *testing.T) {
+	if m := ArabicMansionAt(0); m.Index != 0 || m.Name != "Al Sharatain" {
+		t.Errorf("ArabicMansionAt(0) = %+v", m)
+	}
+	if m := ArabicMansionAt(-1); m.Index != 27 || m.Name != "Batn al Hut" || math.Abs(m.Degree-(m.Width-1)) > 1e-9 {
+		t.Errorf("ArabicMansionAt(-1) = %+v", m)
+	}
+
+	tests := []struct {
+		ra    float64
+		index int
+	}{
+		{201.3, 0}, // Spica
+		{0, 12},    // Between Markab and Algenib
+		{84, 19},   // Zi, the smallest mansion
+		{190, 27},  // Last mansion
+	}
+	for _, test := range tests {
+		if m := ChineseMansionAt(test.ra); m.Index != test.index {
+			t.Errorf("ChineseMansionAt(%.1f) = %d %s, expected %d", test.ra, m.Index, m.Name, test.index)
+		}
+	}
+
+	total := 0.0
+	for i := 0; i < 28; i++ {
+		total += ChineseMansionAt(chineseMansions[i].ra).Width
+	}
+	if math.Abs(total-360) > 1e-9 {
+		t.Errorf("Chinese mansions span %.6f°", total)
+	}
+}
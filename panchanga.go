@@ -0,0 +1,249 @@
+// Go Swiss Ephemeris - Panchanga
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"time"
+)
+
+// Widths of the limbs of the panchanga in degrees
+const (
+	TithiSpan  = 12.0 // Tithi, 12° of elongation of the Moon from the Sun
+	KaranaSpan = 6.0  // Karana, half a tithi
+)
+
+// Paksha is a fortnight of the lunar month
+type Paksha int
+
+const (
+	ShuklaPaksha  Paksha = iota // Bright fortnight, from new moon to full moon
+	KrishnaPaksha               // Dark fortnight, from full moon to new moon
+)
+
+// String returns the name of the paksha
+func (p Paksha) String() string {
+	switch p {
+	case ShuklaPaksha:
+		return "Shukla"
+	case KrishnaPaksha:
+		return "Krishna"
+	}
+	return "unknown"
+}
+
+// tithiNames are the names of the tithis of a paksha; the 15th is Purnima in
+// the Shukla and Amavasya in the Krishna paksha
+var tithiNames = [15]string{
+	"Pratipada", "Dwitiya", "Tritiya", "Chaturthi", "Panchami", "Shashthi", "Saptami", "Ashtami",
+	"Navami", "Dashami", "Ekadashi", "Dwadashi", "Trayodashi", "Chaturdashi", "Purnima",
+}
+
+// yogaNames are the 27 yogas from Vishkambha
+var yogaNames = [27]string{
+	"Vishkambha", "Priti", "Ayushman", "Saubhagya", "Shobhana", "Atiganda", "Sukarma", "Dhriti", "Shula",
+	"Ganda", "Vriddhi", "Dhruva", "Vyaghata", "Harshana", "Vajra", "Siddhi", "Vyatipata", "Variyana",
+	"Parigha", "Shiva", "Siddha", "Sadhya", "Shubha", "Shukla", "Brahma", "Indra", "Vaidhriti",
+}
+
+// movableKaranas repeat eight times from the second half of Shukla Pratipada
+var movableKaranas = [7]string{"Bava", "Balava", "Kaulava", "Taitila", "Gara", "Vanija", "Vishti"}
+
+// varaNames are the days of the week from Sunday, in the order of the grahas
+var varaNames = [7]string{"Ravivara", "Somavara", "Mangalavara", "Budhavara", "Guruvara", "Shukravara", "Shanivara"}
+
+// Eighths of the day from sunrise to sunset (1-8) of Rahu Kalam, Gulika
+// Kalam and Yamaganda from Sunday
+var (
+	rahuKalamParts = [7]int{8, 2, 7, 5, 6, 4, 3}
+	gulikaParts    = [7]int{7, 6, 5, 4, 3, 2, 1}
+	yamagandaParts = [7]int{5, 4, 3, 2, 1, 7, 6}
+)
+
+// TithiName returns the name of a tithi (0-29) with its paksha, such as
+// "Shukla Panchami" or "Amavasya"
+func TithiName(tithi int) string {
+	tithi = ((tithi % 30) + 30) % 30
+	switch tithi {
+	case 14:
+		return "Purnima"
+	case 29:
+		return "Amavasya"
+	}
+	return Paksha(tithi/15).String() + " " + tithiNames[tithi%15]
+}
+
+// KaranaName returns the name of a karana (0-59) from the first half of
+// Shukla Pratipada: Kimstughna, eight cycles of the seven movable karanas,
+// then Shakuni, Chatushpada and Naga
+func KaranaName(karana int) string {
+	karana = ((karana % 60) + 60) % 60
+	switch karana {
+	case 0:
+		return "Kimstughna"
+	case 57:
+		return "Shakuni"
+	case 58:
+		return "Chatushpada"
+	case 59:
+		return "Naga"
+	}
+	return movableKaranas[(karana-1)%7]
+}
+
+// PanchangaLimb is one of the limbs of the panchanga with the times at which
+// it started and ends
+type PanchangaLimb struct {
+	Index int     // Index of the limb, see the fields of PanchangaInfo
+	Name  string  // Name of the limb
+	Start float64 // Julian day (UT) the limb started
+	End   float64 // Julian day (UT) the limb ends
+}
+
+// PanchangaPeriod is an inauspicious period of the day
+type PanchangaPeriod struct {
+	Start float64 // Julian day (UT) the period starts
+	End   float64 // Julian day (UT) the period ends
+}
+
+// PanchangaInfo is the panchanga of a day, with the limbs prevailing at
+// sunrise
+type PanchangaInfo struct {
+	Sunrise     float64 // Julian day (UT) of sunrise
+	Sunset      float64 // Julian day (UT) of sunset
+	NextSunrise float64 // Julian day (UT) of the sunrise that ends the day
+	Moonrise    float64 // Julian day (UT) of the first moonrise after sunrise
+	Moonset     float64 // Julian day (UT) of the first moonset after sunrise
+
+	Vara      PanchangaLimb // Weekday from sunrise to sunrise; Index is the Graha ruling it
+	Tithi     PanchangaLimb // Lunar day, 0-14 Shukla and 15-29 Krishna paksha
+	Paksha    Paksha        // Fortnight of the tithi
+	Nakshatra PanchangaLimb // Nakshatra of the Moon (0 = Ashwini)
+	Yoga      PanchangaLimb // Yoga of the sum of the sidereal Sun and Moon (0 = Vishkambha)
+	Karana    PanchangaLimb // Half of a tithi (0 = Kimstughna), see KaranaName
+
+	RahuKalam PanchangaPeriod // Rahu Kalam
+	Gulika    PanchangaPeriod // Gulika Kalam
+	Yamaganda PanchangaPeriod // Yamaganda
+}
+
+// Panchanga returns the panchanga of the civil day of date at the
+// geographic position geopos (longitude, latitude, altitude), in the
+// location of date. The day runs from sunrise to the next sunrise, found
+// with RiseTrans and BitHinduRising, and the limbs are those prevailing at
+// sunrise, with the times at which they start and end. The nakshatra and the
+// yoga are sidereal in the zodiac of sidMode (SidmLahiri, ...). The flags
+// select the ephemeris and default to FlagSwieph.
+//
+// Rahu Kalam, Gulika Kalam and Yamaganda are eighths of the time from
+// sunrise to sunset, depending on the weekday.
+//
+// The error matches ErrCircumpolar if the Sun does not rise or set.
+func Panchanga(date time.Time, geopos [3]float64, sidMode int32, flags int32) (PanchangaInfo, error) {
+	c, unlock := lockSidereal(sidMode, flags)
+	defer unlock()
+
+	y, m, d := date.Date()
+	midnight, err := timeToJD(time.Date(y, m, d, 0, 0, 0, 0, date.Location()))
+	if err != nil {
+		return PanchangaInfo{}, err
+	}
+
+	ephe := ephemerisBits(c.iflag)
+	riseTrans := func(jd float64, body int32, rsmi int32) (float64, error) {
+		result, err := RiseTransE(jd, body, "", ephe, rsmi|BitHinduRising, geopos, 0, 0)
+		return result.Time, c.check(err)
+	}
+
+	var p PanchangaInfo
+	if p.Sunrise, err = riseTrans(midnight, Sun, CalcRise); err != nil {
+		return PanchangaInfo{}, err
+	}
+	if p.Sunset, err = riseTrans(p.Sunrise, Sun, CalcSet); err != nil {
+		return PanchangaInfo{}, err
+	}
+	if p.NextSunrise, err = riseTrans(p.Sunset, Sun, CalcRise); err != nil {
+		return PanchangaInfo{}, err
+	}
+	if p.Moonrise, err = riseTrans(p.Sunrise, Moon, CalcRise); err != nil {
+		return PanchangaInfo{}, err
+	}
+	if p.Moonset, err = riseTrans(p.Sunrise, Moon, CalcSet); err != nil {
+		return PanchangaInfo{}, err
+	}
+
+	weekday := int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Weekday())
+	p.Vara = PanchangaLimb{Index: weekday, Name: varaNames[weekday], Start: p.Sunrise, End: p.NextSunrise}
+
+	step := searchStep(Moon)
+	limb := func(lonAt func(float64) (float64, float64, error), span float64, count int) (PanchangaLimb, error) {
+		lon, _, err := lonAt(p.Sunrise)
+		if err != nil {
+			return PanchangaLimb{}, err
+		}
+		i := int(lon/span) % count
+		start, end, err := spanAround(p.Sunrise, 1.5, step, lonAt, float64(i)*span, normDeg(float64(i+1)*span))
+		return PanchangaLimb{Index: i, Start: start, End: end}, err
+	}
+
+	if p.Tithi, err = limb(c.elongation, TithiSpan, 30); err != nil {
+		return PanchangaInfo{}, err
+	}
+	p.Tithi.Name = TithiName(p.Tithi.Index)
+	p.Paksha = Paksha(p.Tithi.Index / 15)
+
+	if p.Karana, err = limb(c.elongation, KaranaSpan, 60); err != nil {
+		return PanchangaInfo{}, err
+	}
+	p.Karana.Name = KaranaName(p.Karana.Index)
+
+	moonLon := func(jd float64) (float64, float64, error) { return c.lon(jd, Moon) }
+	if p.Nakshatra, err = limb(moonLon, NakshatraSpan, 27); err != nil {
+		return PanchangaInfo{}, err
+	}
+	p.Nakshatra.Name = NakshatraNames()[p.Nakshatra.Index]
+
+	if p.Yoga, err = limb(c.yogaLon, NakshatraSpan, 27); err != nil {
+		return PanchangaInfo{}, err
+	}
+	p.Yoga.Name = yogaNames[p.Yoga.Index]
+
+	eighth := (p.Sunset - p.Sunrise) / 8
+	period := func(part int) PanchangaPeriod {
+		start := p.Sunrise + float64(part-1)*eighth
+		return PanchangaPeriod{Start: start, End: start + eighth}
+	}
+	p.RahuKalam = period(rahuKalamParts[weekday])
+	p.Gulika = period(gulikaParts[weekday])
+	p.Yamaganda = period(yamagandaParts[weekday])
+
+	return p, c.warn
+}
+
+// yogaLon returns the sum of the longitudes of the Sun and the Moon and its
+// speed
+func (c *calculator) yogaLon(jd float64) (float64, float64, error) {
+	moon, moonSpeed, err := c.lon(jd, Moon)
+	if err != nil {
+		return 0, 0, err
+	}
+	sun, sunSpeed, err := c.lon(jd, Sun)
+	if err != nil {
+		return 0, 0, err
+	}
+	return normDeg(moon + sun), moonSpeed + sunSpeed, nil
+}
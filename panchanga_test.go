@@ -0,0 +1,110 @@
+// Go Swiss Ephemeris - Panchanga Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTithiAndKaranaNames(t *testing.T) {
+	tests := []struct {
+		tithi int
+		name  string
+	}{
+		{0, "Shukla Pratipada"},
+		{4, "Shukla Panchami"},
+		{14, "Purnima"},
+		{15, "Krishna Pratipada"},
+		{28, "Krishna Chaturdashi"},
+		{29, "Amavasya"},
+	}
+	for _, test := range tests {
+		if got := TithiName(test.tithi); got != test.name {
+			t.Errorf("TithiName(%d) = %q, expected %q", test.tithi, got, test.name)
+		}
+	}
+
+	karanas := map[int]string{0: "Kimstughna", 1: "Bava", 7: "Vishti", 8: "Bava", 56: "Vishti", 57: "Shakuni", 59: "Naga"}
+	for k, name := range karanas {
+		if got := KaranaName(k); got != name {
+			t.Errorf("KaranaName(%d) = %q, expected %q", k, got, name)
+		}
+	}
+}
+
+func TestPanchanga(t *testing.T) {
+
+	// Monday 15 January 2024 in New Delhi, Shukla Panchami at sunrise
+	ist := time.FixedZone("IST", 5*3600+1800)
+	delhi := [3]float64{77.2090, 28.6139, 216}
+	p, err := Panchanga(time.Date(2024, 1, 15, 12, 0, 0, 0, ist), delhi, SidmLahiri, FlagMoseph)
+	if err != nil {
+		t.Fatalf("Panchanga failed: %v", err)
+	}
+
+	sunrise := TimeFromJD(p.Sunrise).In(ist)
+	if sunrise.Day() != 15 || sunrise.Hour() != 7 {
+		t.Errorf("Sunrise at %v, expected about 07:15 IST", sunrise)
+	}
+	if !(p.Sunrise < p.Moonrise && p.Sunrise < p.Sunset && p.Sunset < p.NextSunrise && p.NextSunrise-p.Sunrise < 1.01) {
+		t.Errorf("Unexpected rise and set times %+v", p)
+	}
+	if p.Vara.Index != int(GrahaMoon) || p.Vara.Name != "Somavara" || p.Vara.Start != p.Sunrise {
+		t.Errorf("Vara %+v, expected Somavara", p.Vara)
+	}
+	if p.Tithi.Index != 4 || p.Tithi.Name != "Shukla Panchami" || p.Paksha != ShuklaPaksha {
+		t.Errorf("Tithi %+v, expected Shukla Panchami", p.Tithi)
+	}
+	if p.Karana.Index/2 != p.Tithi.Index {
+		t.Errorf("Karana %+v outside tithi %d", p.Karana, p.Tithi.Index)
+	}
+
+	c, unlock := lockSidereal(SidmLahiri, FlagMoseph)
+	defer unlock()
+	elong := func(jd float64) float64 { e, _, _ := c.elongation(jd); return e }
+	if d := angleDiff(elong(p.Tithi.End), float64(p.Tithi.Index+1)*TithiSpan); math.Abs(d) > 1e-5 {
+		t.Errorf("Tithi ends %.6f° away from its boundary", d)
+	}
+	if d := angleDiff(elong(p.Tithi.Start), float64(p.Tithi.Index)*TithiSpan); math.Abs(d) > 1e-5 {
+		t.Errorf("Tithi starts %.6f° away from its boundary", d)
+	}
+	moon, _, _ := c.lon(p.Sunrise, Moon)
+	if n := NakshatraAt(moon, Nakshatras27); p.Nakshatra.Index != n.Index || p.Nakshatra.Name != n.Name {
+		t.Errorf("Nakshatra %+v, expected %s", p.Nakshatra, n.Name)
+	}
+	sum, _, _ := c.yogaLon(p.Yoga.End)
+	if d := angleDiff(sum, float64(p.Yoga.Index+1)*NakshatraSpan); math.Abs(d) > 1e-5 {
+		t.Errorf("Yoga ends %.6f° away from its boundary", d)
+	}
+	for _, l := range []PanchangaLimb{p.Tithi, p.Karana, p.Nakshatra, p.Yoga} {
+		if !(l.Start <= p.Sunrise && p.Sunrise < l.End) {
+			t.Errorf("%s from %.4f to %.4f does not prevail at sunrise %.4f", l.Name, l.Start, l.End, p.Sunrise)
+		}
+	}
+
+	// Rahu Kalam is the second eighth of a Monday
+	eighth := (p.Sunset - p.Sunrise) / 8
+	if math.Abs(p.RahuKalam.Start-(p.Sunrise+eighth)) > 1e-9 || math.Abs(p.RahuKalam.End-p.RahuKalam.Start-eighth) > 1e-9 {
+		t.Errorf("Rahu Kalam %+v", p.RahuKalam)
+	}
+	if math.Abs(p.Yamaganda.Start-(p.Sunrise+3*eighth)) > 1e-9 || math.Abs(p.Gulika.Start-(p.Sunrise+5*eighth)) > 1e-9 {
+		t.Errorf("Yamaganda %+v, Gulika %+v", p.Yamaganda, p.Gulika)
+	}
+}
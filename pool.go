@@ -0,0 +1,115 @@
+// Go Swiss Ephemeris - Thread Pool
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// CalcRequest describes a single CalcUT call in a batch
+type CalcRequest struct {
+	TjdUt float64 // Julian day (universal time)
+	Ipl   int32   // Planet number
+	Iflag int32   // Calculation flags
+}
+
+// Pool is a set of Ephemeris handles sharing the same settings, each running
+// on its own locked OS thread, used to spread batch calculations over
+// several CPU cores.
+type Pool struct {
+	handles []*Ephemeris
+}
+
+// NewPool creates a pool of n Ephemeris handles configured with opts.
+// If n is not positive, runtime.NumCPU() handles are created.
+func NewPool(n int, opts ...EphemerisOption) *Pool {
+	if n <= 0 {
+		n = runtime.NumCPU()
+	}
+
+	p := &Pool{handles: make([]*Ephemeris, n)}
+	for i := range p.handles {
+		p.handles[i] = NewEphemeris(opts...)
+	}
+
+	return p
+}
+
+// Size returns the number of threads in the pool
+func (p *Pool) Size() int {
+	return len(p.handles)
+}
+
+// Close stops all threads of the pool
+func (p *Pool) Close() {
+	for _, h := range p.handles {
+		h.Close()
+	}
+}
+
+// BatchCalcUT calculates all requests in parallel on the pool threads.
+// Results are returned in request order; a failed calculation is reported
+// through the Flag and Error fields of its result. If ctx is cancelled,
+// requests not yet calculated are returned with Flag set to ERR and the
+// context error as Error.
+func (p *Pool) BatchCalcUT(ctx context.Context, reqs []CalcRequest) []CalcResult {
+	results := make([]CalcResult, len(reqs))
+	done := ctx.Done()
+
+	var next int64 = -1
+	var wg sync.WaitGroup
+	for _, h := range p.handles {
+		wg.Add(1)
+		go func(h *Ephemeris) {
+			defer wg.Done()
+			h.Do(func() {
+				for {
+					i := int(atomic.AddInt64(&next, 1))
+					if i >= len(reqs) {
+						return
+					}
+					select {
+					case <-done:
+						return
+					default:
+					}
+					req := reqs[i]
+					results[i] = CalcUT(req.TjdUt, req.Ipl, req.Iflag)
+				}
+			})
+		}(h)
+	}
+	wg.Wait()
+
+	// Requests skipped because of cancellation or a closed pool have no Data
+	for i := range results {
+		if results[i].Data != nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			results[i] = errCalcResult(err)
+		} else {
+			results[i] = errCalcResult(ErrEphemerisClosed)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,76 @@
+// Go Swiss Ephemeris - Thread Pool Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPool_BatchCalcUT(t *testing.T) {
+	pool := NewPool(4)
+	defer pool.Close()
+
+	jd := Julday(2000, 1, 1, 12.0, GregCal)
+	reqs := make([]CalcRequest, 500)
+	for i := range reqs {
+		reqs[i] = CalcRequest{TjdUt: jd + float64(i), Ipl: int32(i % 10), Iflag: FlagMoseph | FlagSpeed}
+	}
+
+	results := pool.BatchCalcUT(context.Background(), reqs)
+	if len(results) != len(reqs) {
+		t.Fatalf("Expected %d results, got %d", len(reqs), len(results))
+	}
+
+	for i, req := range reqs {
+		want := CalcUT(req.TjdUt, req.Ipl, req.Iflag)
+		if results[i].Flag != want.Flag || results[i].Data[0] != want.Data[0] {
+			t.Errorf("Result %d: got %.6f (flag %d), expected %.6f (flag %d)",
+				i, results[i].Data[0], results[i].Flag, want.Data[0], want.Flag)
+		}
+	}
+}
+
+func TestPool_BatchCalcUTCancelled(t *testing.T) {
+	pool := NewPool(2)
+	defer pool.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := pool.BatchCalcUT(ctx, []CalcRequest{{TjdUt: 2451545.0, Ipl: Sun, Iflag: FlagMoseph}})
+	if results[0].Flag != ERR || results[0].Error != context.Canceled.Error() {
+		t.Errorf("Expected cancelled result, got flag %d error %q", results[0].Flag, results[0].Error)
+	}
+}
+
+func BenchmarkPool_BatchCalcUT(b *testing.B) {
+	pool := NewPool(0)
+	defer pool.Close()
+
+	jd := Julday(2000, 1, 1, 12.0, GregCal)
+	reqs := make([]CalcRequest, 1000)
+	for i := range reqs {
+		reqs[i] = CalcRequest{TjdUt: jd + float64(i), Ipl: Mars, Iflag: FlagMoseph}
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		pool.BatchCalcUT(context.Background(), reqs)
+	}
+}
@@ -0,0 +1,242 @@
+// Go Swiss Ephemeris - Typed Positions
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/swisseph
+#include "swephexp.h"
+
+// calc_out is returned by value so that Go does not move output buffers
+// passed to the C library to the heap.
+typedef struct {
+	double xx[6];
+	int flag;
+	char serr[AS_MAXCH];
+} calc_out;
+
+static calc_out go_swe_calc(double tjd, int ipl, int iflag) {
+	calc_out o;
+	o.serr[0] = '\0';
+	o.flag = swe_calc(tjd, ipl, iflag, o.xx, o.serr);
+	return o;
+}
+
+static calc_out go_swe_calc_ut(double tjd, int ipl, int iflag) {
+	calc_out o;
+	o.serr[0] = '\0';
+	o.flag = swe_calc_ut(tjd, ipl, iflag, o.xx, o.serr);
+	return o;
+}
+
+static calc_out go_swe_calc_pctr(double tjd, int ipl, int iplctr, int iflag) {
+	calc_out o;
+	o.serr[0] = '\0';
+	o.flag = swe_calc_pctr(tjd, ipl, iplctr, iflag, o.xx, o.serr);
+	return o;
+}
+*/
+import "C"
+
+// Plane is the reference plane of a position
+type Plane int
+
+const (
+	PlaneEcliptic   Plane = iota // Ecliptic coordinates (default)
+	PlaneEquatorial              // Equatorial coordinates (FlagEquatorial)
+)
+
+// Equinox is the equinox a position is referred to
+type Equinox int
+
+const (
+	EquinoxOfDate   Equinox = iota // True equinox of date (default)
+	EquinoxJ2000                   // Equinox J2000 (FlagJ2000)
+	EquinoxSidereal                // Sidereal zodiac (FlagSidereal)
+)
+
+// AngleUnit is the unit of angles and angular speeds in a position
+type AngleUnit int
+
+const (
+	UnitDegrees AngleUnit = iota // Degrees, degrees per day (default)
+	UnitRadians                  // Radians, radians per day (FlagRadians)
+)
+
+// Position is a planetary position with named coordinates.
+//
+// Which fields are set depends on the calculation flags:
+//   - ecliptic polar (default): Lon, Lat, Dist and their speeds
+//   - equatorial polar (FlagEquatorial): RA, Dec, Dist and their speeds
+//   - cartesian (FlagXYZ): X, Y, Z and their speeds, in the plane given by Plane
+//
+// Distances are in AU and speeds are per day. Speeds are only set if
+// FlagSpeed was used.
+type Position struct {
+	Lon      float64 // Ecliptic longitude
+	Lat      float64 // Ecliptic latitude
+	LonSpeed float64 // Speed in longitude
+	LatSpeed float64 // Speed in latitude
+
+	RA       float64 // Right ascension
+	Dec      float64 // Declination
+	RASpeed  float64 // Speed in right ascension
+	DecSpeed float64 // Speed in declination
+
+	Dist      float64 // Distance
+	DistSpeed float64 // Speed in distance
+
+	X      float64 // Cartesian X
+	Y      float64 // Cartesian Y
+	Z      float64 // Cartesian Z
+	XSpeed float64 // Speed in X
+	YSpeed float64 // Speed in Y
+	ZSpeed float64 // Speed in Z
+
+	Plane     Plane     // Reference plane
+	Cartesian bool      // True if X, Y, Z are set instead of polar coordinates
+	Equinox   Equinox   // Reference equinox
+	Units     AngleUnit // Unit of angles
+	Flag      int32     // Flag returned by the calculation
+
+	Fallback *EphemerisFallback // Set if another ephemeris than requested was used
+}
+
+// NewPosition names the six values returned by a calculation made with iflag
+func NewPosition(xx [6]float64, iflag int32) Position {
+	pos := Position{Flag: iflag}
+
+	if iflag&FlagEquatorial != 0 {
+		pos.Plane = PlaneEquatorial
+	}
+	switch {
+	case iflag&FlagSidereal != 0:
+		pos.Equinox = EquinoxSidereal
+	case iflag&FlagJ2000 != 0:
+		pos.Equinox = EquinoxJ2000
+	}
+	if iflag&FlagRadians != 0 {
+		pos.Units = UnitRadians
+	}
+
+	switch {
+	case iflag&FlagXYZ != 0:
+		pos.Cartesian = true
+		pos.X, pos.Y, pos.Z = xx[0], xx[1], xx[2]
+		pos.XSpeed, pos.YSpeed, pos.ZSpeed = xx[3], xx[4], xx[5]
+	case pos.Plane == PlaneEquatorial:
+		pos.RA, pos.Dec, pos.Dist = xx[0], xx[1], xx[2]
+		pos.RASpeed, pos.DecSpeed, pos.DistSpeed = xx[3], xx[4], xx[5]
+	default:
+		pos.Lon, pos.Lat, pos.Dist = xx[0], xx[1], xx[2]
+		pos.LonSpeed, pos.LatSpeed, pos.DistSpeed = xx[3], xx[4], xx[5]
+	}
+
+	return pos
+}
+
+// Array returns the position as the six values of the C library
+func (p Position) Array() [6]float64 {
+	switch {
+	case p.Cartesian:
+		return [6]float64{p.X, p.Y, p.Z, p.XSpeed, p.YSpeed, p.ZSpeed}
+	case p.Plane == PlaneEquatorial:
+		return [6]float64{p.RA, p.Dec, p.Dist, p.RASpeed, p.DecSpeed, p.DistSpeed}
+	}
+	return [6]float64{p.Lon, p.Lat, p.Dist, p.LonSpeed, p.LatSpeed, p.DistSpeed}
+}
+
+// CalcArray calculates planetary positions (ephemeris time) without
+// allocating. It returns the six values, the return flag and an error as
+// returned by CalcE.
+func CalcArray(tjdEt float64, ipl int32, iflag int32) ([6]float64, int32, error) {
+	out := C.go_swe_calc(C.double(tjdEt), C.int(ipl), C.int(iflag))
+	return calcOutArray("swe_calc", iflag, &out)
+}
+
+// CalcUTArray calculates planetary positions (universal time) without
+// allocating. It returns the six values, the return flag and an error as
+// returned by CalcUTE.
+func CalcUTArray(tjdUt float64, ipl int32, iflag int32) ([6]float64, int32, error) {
+	out := C.go_swe_calc_ut(C.double(tjdUt), C.int(ipl), C.int(iflag))
+	return calcOutArray("swe_calc_ut", iflag, &out)
+}
+
+// CalcPctrArray calculates planetocentric positions without allocating.
+// It returns the six values, the return flag and an error as returned by
+// CalcPctrE.
+func CalcPctrArray(tjdEt float64, ipl int32, iplctr int32, iflag int32) ([6]float64, int32, error) {
+	out := C.go_swe_calc_pctr(C.double(tjdEt), C.int(ipl), C.int(iplctr), C.int(iflag))
+	return calcOutArray("swe_calc_pctr", iflag, &out)
+}
+
+// CalcPosition calculates a named planetary position (ephemeris time)
+func CalcPosition(tjdEt float64, ipl int32, iflag int32) (Position, error) {
+	xx, flag, err := CalcArray(tjdEt, ipl, iflag)
+	return newCalcPosition(xx, iflag, flag, err), err
+}
+
+// CalcUTPosition calculates a named planetary position (universal time)
+func CalcUTPosition(tjdUt float64, ipl int32, iflag int32) (Position, error) {
+	xx, flag, err := CalcUTArray(tjdUt, ipl, iflag)
+	return newCalcPosition(xx, iflag, flag, err), err
+}
+
+// CalcPctrPosition calculates a named planetocentric position
+func CalcPctrPosition(tjdEt float64, ipl int32, iplctr int32, iflag int32) (Position, error) {
+	xx, flag, err := CalcPctrArray(tjdEt, ipl, iplctr, iflag)
+	return newCalcPosition(xx, iflag, flag, err), err
+}
+
+// newCalcPosition builds a Position from a calculation; the coordinate layout
+// follows the requested flags, Flag is the returned flag
+func newCalcPosition(xx [6]float64, iflag int32, retflag int32, err error) Position {
+	pos := NewPosition(xx, iflag)
+	pos.Flag = retflag
+	if seErr, ok := err.(*Error); ok {
+		pos.Fallback = ephemerisFallback(iflag, retflag, seErr.Message)
+	}
+	return pos
+}
+
+// calcOutArray converts the output of a calc helper; serr is only converted
+// to a Go string when it is not empty, so successful calls do not allocate
+func calcOutArray(fn string, iflag int32, out *C.calc_out) ([6]float64, int32, error) {
+	xx := [6]float64{
+		float64(out.xx[0]), float64(out.xx[1]), float64(out.xx[2]),
+		float64(out.xx[3]), float64(out.xx[4]), float64(out.xx[5]),
+	}
+	flag := int32(out.flag)
+
+	if flag >= 0 && out.serr[0] == 0 && ephemerisFallback(iflag, flag, "") == nil {
+		return xx, flag, nil
+	}
+	return xx, flag, calcError(fn, iflag, flag, serrString(&out.serr))
+}
+
+// serrString converts a C error buffer without passing it to C.GoString,
+// which would move the buffer to the heap
+func serrString(serr *[asMaxch]C.char) string {
+	buf := make([]byte, 0, asMaxch)
+	for _, c := range serr {
+		if c == 0 {
+			break
+		}
+		buf = append(buf, byte(c))
+	}
+	return string(buf)
+}
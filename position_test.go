@@ -0,0 +1,82 @@
+// Go Swiss Ephemeris - Typed Position Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"testing"
+)
+
+func TestCalcUTPosition(t *testing.T) {
+	jd := Julday(2000, 1, 1, 12.0, GregCal)
+
+	tests := []struct {
+		name  string
+		iflag int32
+		check func(Position, [6]float64) bool
+	}{
+		{"ecliptic", FlagMoseph | FlagSpeed, func(p Position, xx [6]float64) bool {
+			return p.Plane == PlaneEcliptic && !p.Cartesian && p.Lon == xx[0] && p.Lat == xx[1] && p.LonSpeed == xx[3]
+		}},
+		{"equatorial", FlagMoseph | FlagSpeed | FlagEquatorial, func(p Position, xx [6]float64) bool {
+			return p.Plane == PlaneEquatorial && p.RA == xx[0] && p.Dec == xx[1] && p.Lon == 0
+		}},
+		{"cartesian", FlagMoseph | FlagXYZ | FlagJ2000, func(p Position, xx [6]float64) bool {
+			return p.Cartesian && p.Equinox == EquinoxJ2000 && p.X == xx[0] && p.Z == xx[2] && p.Dist == 0
+		}},
+		{"radians", FlagMoseph | FlagRadians, func(p Position, xx [6]float64) bool {
+			return p.Units == UnitRadians && p.Lon == xx[0] && p.Lon < 7
+		}},
+	}
+
+	for _, test := range tests {
+		pos, err := CalcUTPosition(jd, Moon, test.iflag)
+		if err != nil {
+			t.Errorf("%s: CalcUTPosition failed: %v", test.name, err)
+			continue
+		}
+		result := CalcUT(jd, Moon, test.iflag)
+		var xx [6]float64
+		copy(xx[:], result.Data)
+		if !test.check(pos, xx) {
+			t.Errorf("%s: unexpected position %+v for data %v", test.name, pos, xx)
+		}
+		if pos.Array() != xx {
+			t.Errorf("%s: Array() = %v, expected %v", test.name, pos.Array(), xx)
+		}
+	}
+}
+
+func TestCalcUTArrayAllocs(t *testing.T) {
+	jd := Julday(2000, 1, 1, 12.0, GregCal)
+	allocs := testing.AllocsPerRun(100, func() {
+		CalcUTArray(jd, Mars, FlagMoseph|FlagSpeed)
+	})
+	if allocs != 0 {
+		t.Errorf("CalcUTArray allocated %.0f times per call, expected 0", allocs)
+	}
+}
+
+func BenchmarkCalcUTArray(b *testing.B) {
+	jd := Julday(2000, 1, 1, 12.0, GregCal)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		CalcUTArray(jd, Sun, FlagMoseph)
+	}
+}
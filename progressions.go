@@ -0,0 +1,425 @@
+// Go Swiss Ephemeris - Progressions and Directions
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"sort"
+)
+
+// Constants of progressions and directions
+const (
+	TropicalYear  = 365.242189 // Mean tropical year in days
+	TropicalMonth = 27.321582  // Mean tropical month in days
+	NaibodRate    = 0.98564733 // Mean daily motion of the Sun in degrees
+)
+
+// ProgressionMethod selects how the time of life maps to the time of the
+// progressed chart
+type ProgressionMethod int
+
+const (
+	SecondaryProgression ProgressionMethod = iota // One day after birth for each year of life
+	TertiaryProgression                           // One day after birth for each tropical month of life
+	MinorProgression                              // One tropical month after birth for each year of life
+)
+
+// MCMethod selects how the MC of a progressed chart moves
+type MCMethod int
+
+const (
+	MCNaibod         MCMethod = iota // The ARMC advances by the mean motion of the Sun for each progressed day
+	MCSolarArcRA                     // The ARMC advances by the motion of the progressed Sun in right ascension
+	MCSolarArc                       // The MC advances by the motion of the progressed Sun in longitude (true solar arc)
+	MCProgressedTime                 // The houses are calculated for the progressed moment
+)
+
+// ProgressionOptions configures Progress
+type ProgressionOptions struct {
+	Method     ProgressionMethod // Secondary, tertiary or minor progression
+	YearLength float64           // Length of a year of life in days; defaults to TropicalYear
+	MC         MCMethod          // Progression of the MC and the houses
+}
+
+// ProgressedJD returns the Julian day of the progressed chart of a birth at
+// natalJD for the moment targetJD
+func ProgressedJD(natalJD, targetJD float64, method ProgressionMethod, yearLength float64) float64 {
+	if yearLength <= 0 {
+		yearLength = TropicalYear
+	}
+	age := targetJD - natalJD
+
+	switch method {
+	case TertiaryProgression:
+		return natalJD + age/TropicalMonth
+	case MinorProgression:
+		return natalJD + age/yearLength*TropicalMonth
+	}
+	return natalJD + age/yearLength
+}
+
+// Progress returns the progressed chart of a natal chart for the moment
+// targetJD (UT). The bodies are calculated for the progressed moment with the
+// options of the natal chart; the houses are calculated from the progressed
+// ARMC with HousesArmc, and house placements are taken along the ecliptic.
+func Progress(natal *Chart, targetJD float64, opts ProgressionOptions) (*Chart, error) {
+	defer natal.lockThread()()
+
+	progJD := ProgressedJD(natal.JD, targetJD, opts.Method, opts.YearLength)
+
+	chart, warn := NewChartJD(progJD, natal.Geopos, natal.opts...)
+	if warn != nil && !IsWarning(warn) {
+		return nil, warn
+	}
+	if opts.MC == MCProgressedTime {
+		return chart, warn
+	}
+
+	var armc float64
+	switch opts.MC {
+	case MCSolarArcRA:
+		// The ARMC is a tropical right ascension
+		flags := (natal.Flags | FlagEquatorial) &^ FlagSidereal
+		natalSun, err := sunPosition(natal.JD, flags)
+		if err != nil {
+			return nil, err
+		}
+		progSun, err := sunPosition(progJD, flags)
+		if err != nil {
+			return nil, err
+		}
+		armc = natal.Angles[ARMC] + normDeg(progSun-natalSun)
+	case MCSolarArc:
+		arc, err := solarArc(natal, progJD)
+		if err != nil {
+			return nil, err
+		}
+		// The MC of a sidereal chart goes back to the tropical zodiac, where
+		// setArmc takes the ayanamsa off again
+		armc = eclipticToRA(natal.Angles[MC]+arc+chart.Ayanamsa, chart.Eps)
+	default:
+		armc = natal.Angles[ARMC] + (progJD-natal.JD)*NaibodRate
+	}
+
+	if err := chart.setArmc(normDeg(armc)); err != nil {
+		return nil, err
+	}
+	return chart, warn
+}
+
+// SolarArcDirections returns the natal chart with all bodies, cusps and
+// angles advanced by the solar arc for the moment targetJD (UT): the motion
+// of the secondary progressed Sun in longitude. Houses of the bodies and the
+// aspect grid are those of the natal chart.
+func SolarArcDirections(natal *Chart, targetJD float64, yearLength float64) (*Chart, error) {
+	defer natal.lockThread()()
+
+	arc, err := solarArc(natal, ProgressedJD(natal.JD, targetJD, SecondaryProgression, yearLength))
+	if err != nil {
+		return nil, err
+	}
+
+	chart := *natal
+	chart.Bodies = make([]ChartBody, len(natal.Bodies))
+	for i, b := range natal.Bodies {
+		b.Position.Lon = normDeg(b.Position.Lon + arc)
+		b.Sign = int(b.Position.Lon / 30)
+		b.SignDegree = b.Position.Lon - float64(b.Sign)*30
+		chart.Bodies[i] = b
+	}
+	chart.Cusps = make([]float64, len(natal.Cusps))
+	for i, cusp := range natal.Cusps {
+		chart.Cusps[i] = normDeg(cusp + arc)
+	}
+	chart.Angles = make([]float64, len(natal.Angles))
+	for i, angle := range natal.Angles {
+		chart.Angles[i] = normDeg(angle + arc)
+	}
+	chart.Angles[ARMC] = eclipticToRA(chart.Angles[MC]+chart.Ayanamsa, chart.Eps)
+
+	return &chart, nil
+}
+
+// solarArc returns the motion in longitude of the Sun from the natal to the
+// progressed moment
+func solarArc(natal *Chart, progJD float64) (float64, error) {
+	natalSun, err := sunPosition(natal.JD, natal.Flags)
+	if err != nil {
+		return 0, err
+	}
+	progSun, err := sunPosition(progJD, natal.Flags)
+	if err != nil {
+		return 0, err
+	}
+	return normDeg(progSun - natalSun), nil
+}
+
+// sunPosition returns the first coordinate of the Sun; warnings are ignored
+// since the chart they are used with already reported them
+func sunPosition(jd float64, flags int32) (float64, error) {
+	c := &calculator{iflag: flags}
+	xx, err := c.calc(jd, Sun)
+	return xx[0], err
+}
+
+// eclipticToRA returns the right ascension of a point of the ecliptic
+func eclipticToRA(lon float64, eps float64) float64 {
+	return normDeg(Cotrans([3]float64{lon, 0, 1}, -eps)[0])
+}
+
+// setArmc replaces the houses of a chart by those of another ARMC and
+// updates the house placements of the bodies
+func (c *Chart) setArmc(armc float64) error {
+	houses, err := HousesArmcEx2E(armc, c.Geopos[1], c.Eps, c.HouseSystem)
+	if err != nil {
+		return err
+	}
+
+	c.Cusps = houses.Houses
+	c.CuspSpeeds = nil
+	c.Angles = houses.Points
+	c.AngleSpeeds = nil
+
+	if c.Ayanamsa != 0 {
+		for i := range c.Cusps {
+			c.Cusps[i] = normDeg(c.Cusps[i] - c.Ayanamsa)
+		}
+		for i := range c.Angles {
+			if i != ARMC {
+				c.Angles[i] = normDeg(c.Angles[i] - c.Ayanamsa)
+			}
+		}
+		// Sign-based houses follow the sidereal signs
+		switch c.HouseSystem {
+		case 'W':
+			for i := range c.Cusps {
+				c.Cusps[i] = normDeg(math.Floor(c.Angles[Asc]/30)*30 + float64(i)*30)
+			}
+		case 'N':
+			for i := range c.Cusps {
+				c.Cusps[i] = float64(i) * 30
+			}
+		}
+	}
+
+	for i := range c.Bodies {
+		c.Bodies[i].HousePos = cuspHousePos(c.Bodies[i].Position.Lon, c.Cusps)
+		c.Bodies[i].House = int(c.Bodies[i].HousePos)
+	}
+	return nil
+}
+
+// DirectionKey converts arcs of direction to years of life
+type DirectionKey int
+
+const (
+	KeyNaibod  DirectionKey = iota // NaibodRate degrees per year
+	KeyPtolemy                     // One degree per year
+)
+
+// PrimaryDirectionOptions configures PrimaryDirections
+type PrimaryDirectionOptions struct {
+	Method     byte         // 'P' for Placidus (semi-arc) or 'R' for Regiomontanus; defaults to 'P'
+	Aspects    []float64    // Aspect angles; defaults to MajorAspects
+	Mundane    bool         // Find mundane aspects, measured in house positions
+	Zodiacal   bool         // Find zodiacal aspects, measured along the ecliptic without latitude
+	Converse   bool         // Also find converse directions
+	Key        DirectionKey // Key converting arcs to years
+	MaxArc     float64      // Largest arc in degrees; defaults to 90
+	YearLength float64      // Length of a year in days; defaults to TropicalYear
+}
+
+// PrimaryDirection is a primary direction of a promissor to a significator
+type PrimaryDirection struct {
+	Promissor    int     // Index of the promissor in Chart.Bodies
+	Significator int     // Index of the significator in Chart.Points()
+	Aspect       float64 // Aspect angle added to the promissor, negative when counted backwards
+	Zodiacal     bool    // Zodiacal instead of mundane aspect
+	Converse     bool    // Converse instead of direct direction
+	Arc          float64 // Arc of direction in degrees of ARMC
+	Years        float64 // Age at which the direction is exact
+	JD           float64 // Julian day (UT) at which the direction is exact
+}
+
+// PrimaryDirections finds the primary directions of the bodies of a natal
+// chart to its bodies, Ascendant and MC, ordered by arc.
+//
+// A direction is the rotation of the sky, measured in ARMC, that brings the
+// promissor to the mundane position of the significator, given by HousePos
+// in the house system of the method. For mundane aspects the promissor must
+// reach the mundane position of the significator plus the aspect, counted at
+// 30° per house; for zodiacal aspects the aspect point of the promissor in
+// the ecliptic must reach the significator. Points that are circumpolar at the
+// birth place are skipped with the Placidus method.
+func PrimaryDirections(natal *Chart, opts PrimaryDirectionOptions) ([]PrimaryDirection, error) {
+	method := opts.Method
+	if method == 0 {
+		method = 'P'
+	}
+	aspects := opts.Aspects
+	if len(aspects) == 0 {
+		aspects = MajorAspects()
+	}
+	maxArc := opts.MaxArc
+	if maxArc <= 0 {
+		maxArc = 90
+	}
+	yearLength := opts.YearLength
+	if yearLength <= 0 {
+		yearLength = TropicalYear
+	}
+	if !opts.Mundane && !opts.Zodiacal {
+		opts.Mundane = true
+	}
+
+	geolat := natal.Geopos[1]
+	eps := natal.Eps
+	armc := natal.Angles[ARMC]
+
+	// Mundane positions measured in house positions are tropical
+	ecl := func(lon float64) float64 { return lon + natal.Ayanamsa }
+
+	// mundane returns the mundane position of a point in degrees, 30° per house
+	mundane := func(armc, lon, lat float64) (float64, error) {
+		hpos, err := HousePos(normDeg(armc), geolat, eps, method, ecl(lon), lat)
+		return (hpos - 1) * 30, err
+	}
+
+	// circumpolar reports whether a point never rises or never sets
+	circumpolar := func(lon, lat float64) bool {
+		dec := Cotrans([3]float64{ecl(lon), lat, 1}, -eps)[1]
+		return method == 'P' && math.Abs(dec) >= 90-math.Abs(geolat)
+	}
+
+	type point struct{ lon, lat float64 }
+	significators := make([]point, 0, len(natal.Bodies)+2)
+	for _, b := range natal.Bodies {
+		significators = append(significators, point{b.Position.Lon, b.Position.Lat})
+	}
+	significators = append(significators, point{natal.Angles[Asc], 0}, point{natal.Angles[MC], 0})
+
+	var signed []float64
+	for _, a := range aspects {
+		a = math.Abs(angleDiff(a, 0))
+		signed = append(signed, a)
+		if a != 0 && a != 180 {
+			signed = append(signed, -a)
+		}
+	}
+
+	var directions []PrimaryDirection
+	for si, sig := range significators {
+		if circumpolar(sig.lon, sig.lat) {
+			continue
+		}
+		target, err := mundane(armc, sig.lon, sig.lat)
+		if err != nil {
+			return nil, err
+		}
+
+		for pi, b := range natal.Bodies {
+			if pi == si {
+				continue
+			}
+			for _, a := range signed {
+				for _, zodiacal := range []bool{false, true} {
+					if (zodiacal && !opts.Zodiacal) || (!zodiacal && !opts.Mundane) {
+						continue
+					}
+
+					// The promissor point and its target mundane position
+					lon, lat, goal := b.Position.Lon, b.Position.Lat, normDeg(target+a)
+					if zodiacal {
+						lon, lat, goal = normDeg(lon+a), 0, target
+					}
+					if circumpolar(lon, lat) {
+						continue
+					}
+
+					f := func(arc float64) (float64, error) {
+						pos, err := mundane(armc+arc, lon, lat)
+						return angleDiff(pos, goal), err
+					}
+
+					for _, converse := range []bool{false, true} {
+						if converse && !opts.Converse {
+							continue
+						}
+						arc, found, err := findArc(f, maxArc, converse)
+						if err != nil {
+							return nil, err
+						}
+						if !found {
+							continue
+						}
+
+						d := PrimaryDirection{
+							Promissor:    pi,
+							Significator: si,
+							Aspect:       a,
+							Zodiacal:     zodiacal,
+							Converse:     converse,
+							Arc:          arc,
+						}
+						d.Years = arc
+						if opts.Key == KeyNaibod {
+							d.Years = arc / NaibodRate
+						}
+						d.JD = natal.JD + d.Years*yearLength
+						directions = append(directions, d)
+					}
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(directions, func(i, j int) bool { return directions[i].Arc < directions[j].Arc })
+	return directions, nil
+}
+
+// primaryArcStep is the sampling step of arcs of direction in degrees
+const primaryArcStep = 1.0
+
+// findArc returns the smallest arc in (0, maxArc] at which f crosses zero,
+// rotating the sky forward, or backward for converse directions
+func findArc(f func(float64) (float64, error), maxArc float64, converse bool) (float64, bool, error) {
+	dir := 1.0
+	if converse {
+		dir = -1
+	}
+	g := func(arc float64) (float64, error) { return f(dir * arc) }
+
+	a0 := 0.0
+	d0, err := g(a0)
+	if err != nil {
+		return 0, false, err
+	}
+	for a0 < maxArc {
+		a1 := math.Min(a0+primaryArcStep, maxArc)
+		d1, err := g(a1)
+		if err != nil {
+			return 0, false, err
+		}
+		if angleCrossed(d0, d1) {
+			arc, err := findRoot(g, a0, a1, d0, d1)
+			return arc, err == nil, err
+		}
+		a0, d0 = a1, d1
+	}
+	return 0, false, nil
+}
@@ -0,0 +1,213 @@
+// Go Swiss Ephemeris - Progression Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+func testNatalChart(t *testing.T, opts ...ChartOption) *Chart {
+	t.Helper()
+	natal, err := NewChart(testChartTime, testChartGeopos, append([]ChartOption{WithChartFlags(FlagMoseph)}, opts...)...)
+	if err != nil {
+		t.Fatalf("NewChart failed: %v", err)
+	}
+	return natal
+}
+
+func TestProgressedJD(t *testing.T) {
+	natal := 2448088.0
+	target := natal + 30*TropicalYear
+
+	if jd := ProgressedJD(natal, target, SecondaryProgression, 0); math.Abs(jd-natal-30) > 1e-9 {
+		t.Errorf("Secondary progression: %.6f days after birth, expected 30", jd-natal)
+	}
+	if jd := ProgressedJD(natal, target, SecondaryProgression, 365.25); math.Abs(jd-natal-30*TropicalYear/365.25) > 1e-9 {
+		t.Errorf("Secondary progression with a Julian year: %.6f days after birth", jd-natal)
+	}
+	if jd := ProgressedJD(natal, target, MinorProgression, 0); math.Abs(jd-natal-30*TropicalMonth) > 1e-9 {
+		t.Errorf("Minor progression: %.6f days after birth, expected %.6f", jd-natal, 30*TropicalMonth)
+	}
+	if jd := ProgressedJD(natal, natal+10*TropicalMonth, TertiaryProgression, 0); math.Abs(jd-natal-10) > 1e-9 {
+		t.Errorf("Tertiary progression: %.6f days after birth, expected 10", jd-natal)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	natal := testNatalChart(t)
+	target := natal.JD + 30*TropicalYear
+
+	naibod, err := Progress(natal, target, ProgressionOptions{})
+	if err != nil {
+		t.Fatalf("Progress failed: %v", err)
+	}
+	if math.Abs(naibod.JD-natal.JD-30) > 1e-9 {
+		t.Errorf("Progressed chart is %.6f days after birth, expected 30", naibod.JD-natal.JD)
+	}
+	sun := CalcUT(natal.JD+30, Sun, FlagMoseph).Data[0]
+	if math.Abs(naibod.Bodies[0].Position.Lon-sun) > 1e-9 {
+		t.Errorf("Progressed Sun at %.6f, expected %.6f", naibod.Bodies[0].Position.Lon, sun)
+	}
+	if d := angleDiff(naibod.Angles[ARMC], natal.Angles[ARMC]); math.Abs(d-30*NaibodRate) > 1e-6 {
+		t.Errorf("Naibod ARMC advanced by %.6f°, expected %.6f°", d, 30*NaibodRate)
+	}
+	for _, b := range naibod.Bodies {
+		if !inHouse(b.Position.Lon, naibod.Cusps, b.House) {
+			t.Errorf("Progressed %s at %.4f° is not in house %d", b.Name, b.Position.Lon, b.House)
+		}
+	}
+
+	solarArc, err := Progress(natal, target, ProgressionOptions{MC: MCSolarArc})
+	if err != nil {
+		t.Fatalf("Progress failed: %v", err)
+	}
+	arc := angleDiff(sun, natal.Bodies[0].Position.Lon)
+	if d := angleDiff(solarArc.Angles[MC], natal.Angles[MC]); math.Abs(d-arc) > 1e-6 {
+		t.Errorf("Solar arc MC advanced by %.6f°, expected %.6f°", d, arc)
+	}
+
+	solarArcRA, err := Progress(natal, target, ProgressionOptions{MC: MCSolarArcRA})
+	if err != nil {
+		t.Fatalf("Progress failed: %v", err)
+	}
+	ra0 := CalcUT(natal.JD, Sun, FlagMoseph|FlagEquatorial).Data[0]
+	ra1 := CalcUT(natal.JD+30, Sun, FlagMoseph|FlagEquatorial).Data[0]
+	if d := angleDiff(solarArcRA.Angles[ARMC], natal.Angles[ARMC]); math.Abs(d-angleDiff(ra1, ra0)) > 1e-6 {
+		t.Errorf("Solar arc in RA advanced the ARMC by %.6f°, expected %.6f°", d, angleDiff(ra1, ra0))
+	}
+
+	progressedTime, err := Progress(natal, target, ProgressionOptions{MC: MCProgressedTime})
+	if err != nil {
+		t.Fatalf("Progress failed: %v", err)
+	}
+	houses := HousesEx(natal.JD+30, 0, testChartGeopos[1], testChartGeopos[0], 'P')
+	if math.Abs(progressedTime.Angles[Asc]-houses.Points[Asc]) > 1e-9 {
+		t.Error("Houses of the progressed moment differ from HousesEx")
+	}
+}
+
+func TestSolarArcDirections(t *testing.T) {
+	natal := testNatalChart(t)
+	target := natal.JD + 40*TropicalYear
+
+	directed, err := SolarArcDirections(natal, target, 0)
+	if err != nil {
+		t.Fatalf("SolarArcDirections failed: %v", err)
+	}
+
+	arc := angleDiff(CalcUT(natal.JD+40, Sun, FlagMoseph).Data[0], natal.Bodies[0].Position.Lon)
+	for i, b := range directed.Bodies {
+		if d := angleDiff(b.Position.Lon, natal.Bodies[i].Position.Lon); math.Abs(d-arc) > 1e-9 {
+			t.Errorf("%s directed by %.6f°, expected %.6f°", b.Name, d, arc)
+		}
+		if b.House != natal.Bodies[i].House {
+			t.Errorf("%s changed house", b.Name)
+		}
+	}
+	if d := angleDiff(directed.Cusps[0], natal.Cusps[0]); math.Abs(d-arc) > 1e-9 {
+		t.Errorf("Ascendant directed by %.6f°, expected %.6f°", d, arc)
+	}
+	if natal.Bodies[0].Position.Lon == directed.Bodies[0].Position.Lon {
+		t.Error("The natal chart was modified")
+	}
+}
+
+func TestPrimaryDirections(t *testing.T) {
+	natal := testNatalChart(t)
+	points := natal.Points()
+	mc := len(points) - 1
+
+	for _, method := range []byte{'P', 'R'} {
+		directions, err := PrimaryDirections(natal, PrimaryDirectionOptions{
+			Method:   method,
+			Aspects:  []float64{0},
+			Mundane:  true,
+			Zodiacal: true,
+			Converse: true,
+			Key:      KeyPtolemy,
+			MaxArc:   180,
+		})
+		if err != nil {
+			t.Fatalf("PrimaryDirections failed: %v", err)
+		}
+		if len(directions) == 0 {
+			t.Fatalf("No directions found with method %c", method)
+		}
+
+		// A promissor reaches the MC when the ARMC reaches its right
+		// ascension, with both methods
+		found := 0
+		for _, d := range directions {
+			if d.Significator != mc || d.Zodiacal {
+				continue
+			}
+			found++
+			ra := CalcUT(natal.JD, natal.Bodies[d.Promissor].Body, FlagMoseph|FlagEquatorial).Data[0]
+			want := normDeg(ra - natal.Angles[ARMC])
+			if d.Converse {
+				want = 360 - want
+			}
+			if math.Abs(d.Arc-want) > 1e-5 || d.Years != d.Arc {
+				t.Errorf("%c: %s to MC arc %.6f°, expected %.6f°", method, natal.Bodies[d.Promissor].Name, d.Arc, want)
+			}
+		}
+		if found == 0 {
+			t.Errorf("%c: no mundane directions to the MC", method)
+		}
+
+		for i := 1; i < len(directions); i++ {
+			if directions[i].Arc < directions[i-1].Arc {
+				t.Fatal("Directions are not ordered by arc")
+			}
+		}
+	}
+}
+
+func TestSolarArcSidereal(t *testing.T) {
+	natal := testNatalChart(t, WithSidereal(SidmLahiri))
+	target := natal.JD + 30*TropicalYear
+
+	arc, err := solarArc(natal, natal.JD+30)
+	if err != nil {
+		t.Fatalf("solarArc failed: %v", err)
+	}
+
+	progressed, err := Progress(natal, target, ProgressionOptions{MC: MCSolarArc})
+	if err != nil {
+		t.Fatalf("Progress failed: %v", err)
+	}
+	if d := angleDiff(progressed.Angles[MC], natal.Angles[MC]); math.Abs(d-arc) > 1e-6 {
+		t.Errorf("Sidereal solar arc MC advanced by %.6f°, expected %.6f°", d, arc)
+	}
+	tropicalMC := natal.Angles[MC] + arc + progressed.Ayanamsa
+	if d := angleDiff(progressed.Angles[ARMC], eclipticToRA(tropicalMC, progressed.Eps)); math.Abs(d) > 1e-6 {
+		t.Errorf("Sidereal solar arc ARMC off by %.6f°", d)
+	}
+
+	directed, err := SolarArcDirections(natal, target, 0)
+	if err != nil {
+		t.Fatalf("SolarArcDirections failed: %v", err)
+	}
+	if d := angleDiff(directed.Angles[ARMC], eclipticToRA(natal.Angles[MC]+arc+natal.Ayanamsa, natal.Eps)); math.Abs(d) > 1e-9 {
+		t.Errorf("Directed ARMC off by %.6f°", d)
+	}
+	if d := angleDiff(directed.Angles[ARMC], natal.Angles[ARMC]); d < arc*0.8 || d > arc*1.2 {
+		t.Errorf("Directed ARMC advanced by %.6f° for a solar arc of %.6f°", d, arc)
+	}
+}
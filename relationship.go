@@ -0,0 +1,152 @@
+// Go Swiss Ephemeris - Relationship Charts
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+// HouseOverlay is a point of one chart in the houses of another
+type HouseOverlay struct {
+	Point    int     // Index of the point in Synastry.Points1 or Synastry.Points2
+	House    int     // House of the other chart (1-12)
+	HousePos float64 // Position between the cusps of the other chart, such as 10.5 for the middle of house 10
+}
+
+// Synastry compares two charts
+type Synastry struct {
+	Points1   []ChartPoint   // Points of the first chart, see Chart.Points
+	Points2   []ChartPoint   // Points of the second chart
+	Aspects   []ChartAspect  // Aspects between the charts; Body1 indexes Points1 and Body2 indexes Points2
+	Overlays1 []HouseOverlay // Points of the first chart in the houses of the second
+	Overlays2 []HouseOverlay // Points of the second chart in the houses of the first
+}
+
+// NewSynastry compares the bodies and angles of two charts. The aspects
+// default to those the first chart was built with. House overlays are
+// measured along the ecliptic between the cusps. Both charts should use the
+// same zodiac.
+func NewSynastry(chart1, chart2 *Chart, aspects ...Aspect) *Synastry {
+	if len(aspects) == 0 {
+		aspects = newChartConfig(chart1.opts).aspects
+	}
+
+	s := &Synastry{
+		Points1: chart1.Points(),
+		Points2: chart2.Points(),
+	}
+	s.Aspects = CalcCrossAspects(s.Points1, s.Points2, aspects)
+	s.Overlays1 = houseOverlays(s.Points1, chart2.Cusps)
+	s.Overlays2 = houseOverlays(s.Points2, chart1.Cusps)
+	return s
+}
+
+func houseOverlays(points []ChartPoint, cusps []float64) []HouseOverlay {
+	overlays := make([]HouseOverlay, len(points))
+	for i, p := range points {
+		pos := cuspHousePos(p.Lon, cusps)
+		overlays[i] = HouseOverlay{Point: i, House: int(pos), HousePos: pos}
+	}
+	return overlays
+}
+
+// Composite returns the composite chart of two charts. Each body is placed at
+// the midpoint of its two positions on the shorter arc; bodies missing from
+// the second chart are left out. The houses are calculated from the midpoint
+// of the two ARMCs at the mean latitude, with the mean obliquity.
+//
+// JD and Geopos are the midpoints in time and place, for reference only.
+// The options are applied after those of the first chart; WithHouseSystem,
+// WithBodies and WithAspects are used.
+func Composite(chart1, chart2 *Chart, opts ...ChartOption) (*Chart, error) {
+	chartOpts := append(append([]ChartOption(nil), chart1.opts...), opts...)
+	cfg := newChartConfig(chartOpts)
+
+	composite := &Chart{
+		JD:          (chart1.JD + chart2.JD) / 2,
+		Geopos:      midpointLocation(chart1.Geopos, chart2.Geopos),
+		HouseSystem: cfg.hsys,
+		Flags:       chart1.Flags,
+		Eps:         (chart1.Eps + chart2.Eps) / 2,
+		Ayanamsa:    (chart1.Ayanamsa + chart2.Ayanamsa) / 2,
+		opts:        chartOpts,
+	}
+
+	for _, body := range cfg.bodies {
+		b1, ok1 := chart1.body(body)
+		b2, ok2 := chart2.body(body)
+		if !ok1 || !ok2 {
+			continue
+		}
+		p1, p2 := b1.Position, b2.Position
+		xx := [6]float64{
+			DegMidp(p1.Lon, p2.Lon),
+			(p1.Lat + p2.Lat) / 2,
+			(p1.Dist + p2.Dist) / 2,
+			(p1.LonSpeed + p2.LonSpeed) / 2,
+			(p1.LatSpeed + p2.LatSpeed) / 2,
+			(p1.DistSpeed + p2.DistSpeed) / 2,
+		}
+		cb := ChartBody{
+			Body:       body,
+			Name:       b1.Name,
+			Position:   NewPosition(xx, chart1.Flags),
+			Retrograde: xx[3] < 0,
+			Sign:       int(xx[0] / 30),
+		}
+		cb.SignDegree = xx[0] - float64(cb.Sign)*30
+		composite.Bodies = append(composite.Bodies, cb)
+	}
+
+	if err := composite.setArmc(DegMidp(chart1.Angles[ARMC], chart2.Angles[ARMC])); err != nil {
+		return nil, err
+	}
+
+	points := make([]ChartPoint, len(composite.Bodies))
+	for i, b := range composite.Bodies {
+		points[i] = b.Point()
+	}
+	composite.Aspects = CalcAspects(points, cfg.aspects)
+
+	return composite, nil
+}
+
+// Davison returns the Davison relationship chart of two charts: a chart for
+// the midpoint in time and the midpoint in geographic longitude and latitude.
+// The options are applied after those of the first chart.
+func Davison(chart1, chart2 *Chart, opts ...ChartOption) (*Chart, error) {
+	chartOpts := append(append([]ChartOption(nil), chart1.opts...), opts...)
+	jd := (chart1.JD + chart2.JD) / 2
+	return NewChartJD(jd, midpointLocation(chart1.Geopos, chart2.Geopos), chartOpts...)
+}
+
+// midpointLocation returns the midpoint of two geographic locations, with the
+// longitude on the shorter arc
+func midpointLocation(geopos1, geopos2 [3]float64) [3]float64 {
+	lon := DegMidp(geopos1[0], geopos2[0])
+	if lon > 180 {
+		lon -= 360
+	}
+	return [3]float64{lon, (geopos1[1] + geopos2[1]) / 2, (geopos1[2] + geopos2[2]) / 2}
+}
+
+// body returns the body of the chart with a planet number
+func (c *Chart) body(body int32) (ChartBody, bool) {
+	for _, b := range c.Bodies {
+		if b.Body == body {
+			return b, true
+		}
+	}
+	return ChartBody{}, false
+}
@@ -0,0 +1,138 @@
+// Go Swiss Ephemeris - Relationship Chart Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func testPartnerChart(t *testing.T, opts ...ChartOption) *Chart {
+	t.Helper()
+	partner, err := NewChart(time.Date(1988, 3, 2, 6, 15, 0, 0, time.UTC), [3]float64{-73.9857, 40.7484, 0},
+		append([]ChartOption{WithChartFlags(FlagMoseph)}, opts...)...)
+	if err != nil {
+		t.Fatalf("NewChart failed: %v", err)
+	}
+	return partner
+}
+
+func TestSynastry(t *testing.T) {
+	natal := testNatalChart(t)
+	partner := testPartnerChart(t)
+
+	s := NewSynastry(natal, partner)
+	if len(s.Points1) != len(natal.Bodies)+2 || len(s.Overlays1) != len(s.Points1) || len(s.Overlays2) != len(s.Points2) {
+		t.Fatal("Unexpected synastry size")
+	}
+	if len(s.Aspects) == 0 {
+		t.Error("No synastry aspects found")
+	}
+	for _, a := range s.Aspects {
+		p1, p2 := s.Points1[a.Body1], s.Points2[a.Body2]
+		sep := math.Abs(angleDiff(p1.Lon, p2.Lon))
+		if math.Abs(math.Abs(sep-a.Aspect.Angle)-a.Orb) > 1e-9 || a.Orb > a.Aspect.OrbFor(p1.Class, p2.Class) {
+			t.Errorf("%s %s %s: wrong orb %.4f", p1.Name, a.Aspect.Name, p2.Name, a.Orb)
+		}
+	}
+	for _, o := range s.Overlays1 {
+		if !inHouse(s.Points1[o.Point].Lon, partner.Cusps, o.House) {
+			t.Errorf("%s is not in house %d of the partner", s.Points1[o.Point].Name, o.House)
+		}
+	}
+
+	// Every point of a chart is conjunct itself
+	self := NewSynastry(natal, natal)
+	exact := 0
+	for _, a := range self.Aspects {
+		if a.Body1 == a.Body2 && a.Aspect.Angle == 0 && a.Orb == 0 {
+			exact++
+		}
+	}
+	if exact != len(self.Points1) {
+		t.Errorf("%d exact self conjunctions, expected %d", exact, len(self.Points1))
+	}
+	if self.Overlays1[len(self.Overlays1)-1].House != 10 {
+		t.Errorf("MC in house %d of its own chart", self.Overlays1[len(self.Overlays1)-1].House)
+	}
+}
+
+func TestComposite(t *testing.T) {
+	natal := testNatalChart(t)
+	partner := testPartnerChart(t)
+
+	composite, err := Composite(natal, partner)
+	if err != nil {
+		t.Fatalf("Composite failed: %v", err)
+	}
+	if len(composite.Bodies) != len(natal.Bodies) {
+		t.Fatalf("Composite has %d bodies", len(composite.Bodies))
+	}
+	for i, b := range composite.Bodies {
+		l1, l2 := natal.Bodies[i].Position.Lon, partner.Bodies[i].Position.Lon
+		if d1, d2 := math.Abs(angleDiff(b.Position.Lon, l1)), math.Abs(angleDiff(b.Position.Lon, l2)); math.Abs(d1-d2) > 1e-9 || d1 > 90 {
+			t.Errorf("%s at %.4f is not the midpoint of %.4f and %.4f", b.Name, b.Position.Lon, l1, l2)
+		}
+		if !inHouse(b.Position.Lon, composite.Cusps, b.House) {
+			t.Errorf("%s is not in house %d", b.Name, b.House)
+		}
+	}
+
+	armc := DegMidp(natal.Angles[ARMC], partner.Angles[ARMC])
+	houses := HousesArmc(armc, composite.Geopos[1], composite.Eps, 'P')
+	if math.Abs(composite.Angles[Asc]-houses.Points[Asc]) > 1e-9 {
+		t.Error("Composite houses differ from HousesArmc at the midpoint ARMC")
+	}
+	if points := composite.Points(); points[len(points)-1].Speed != 0 {
+		t.Error("Composite MC has a speed")
+	}
+
+	// Only the bodies present in both charts are composed
+	moonless := testPartnerChart(t, WithBodies(Sun, Mercury))
+	composite, err = Composite(natal, moonless)
+	if err != nil {
+		t.Fatalf("Composite failed: %v", err)
+	}
+	if len(composite.Bodies) != 2 || composite.Bodies[1].Body != Mercury {
+		t.Errorf("Unexpected composite bodies %+v", composite.Bodies)
+	}
+}
+
+func TestDavison(t *testing.T) {
+	natal := testNatalChart(t)
+	partner := testPartnerChart(t)
+
+	davison, err := Davison(natal, partner)
+	if err != nil {
+		t.Fatalf("Davison failed: %v", err)
+	}
+	if math.Abs(davison.JD-(natal.JD+partner.JD)/2) > 1e-9 {
+		t.Errorf("Davison chart at %.6f", davison.JD)
+	}
+	lon := (testChartGeopos[0] - 73.9857) / 2
+	lat := (testChartGeopos[1] + 40.7484) / 2
+	if math.Abs(davison.Geopos[0]-lon) > 1e-9 || math.Abs(davison.Geopos[1]-lat) > 1e-9 {
+		t.Errorf("Davison chart at %v", davison.Geopos)
+	}
+
+	// The midpoint of longitudes across the date line
+	if geopos := midpointLocation([3]float64{170, 10, 0}, [3]float64{-160, 20, 0}); math.Abs(geopos[0]+175) > 1e-9 || geopos[1] != 15 {
+		t.Errorf("Midpoint across the date line at %v", geopos)
+	}
+}
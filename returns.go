@@ -0,0 +1,122 @@
+// Go Swiss Ephemeris - Return Charts
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"errors"
+)
+
+// ErrNoReturn is returned when a body does not return to its natal longitude
+// within the search range
+var ErrNoReturn = errors.New("swisseph: no return found")
+
+// ReturnOptions configures return charts
+type ReturnOptions struct {
+	// Precessed corrects the natal longitude of a tropical chart for the
+	// precession since birth, so that the body returns to the same place
+	// among the stars. Sidereal charts need no correction.
+	Precessed bool
+
+	// ChartOptions are applied after the options of the natal chart
+	ChartOptions []ChartOption
+}
+
+// maxReturnSearch is the longest search for a return, in days: more than
+// the sidereal period of Pluto
+const maxReturnSearch = 250 * TropicalYear
+
+// SolarReturn returns the chart of the return of the Sun to its natal
+// longitude in a year, cast for a location given as longitude, latitude and
+// altitude. The zodiac and the other settings are those of the natal chart.
+func SolarReturn(natal *Chart, year int, geopos [3]float64, opts ReturnOptions) (*Chart, error) {
+	birth := Revjul(natal.JD, GregCal)
+	birthday := natal.JD + float64(year-birth.Year)*TropicalYear
+	return findReturn(natal, Sun, birthday-3, birthday+3, geopos, opts)
+}
+
+// LunarReturn returns the chart of the first return of the Moon to its natal
+// longitude after afterJD (UT)
+func LunarReturn(natal *Chart, afterJD float64, geopos [3]float64, opts ReturnOptions) (*Chart, error) {
+	return findReturn(natal, Moon, afterJD, afterJD+TropicalMonth+1, geopos, opts)
+}
+
+// PlanetaryReturn returns the chart of the first return of a body to its
+// natal longitude after afterJD (UT). The first crossing of the natal
+// longitude is used, whatever the direction of motion.
+func PlanetaryReturn(natal *Chart, body int32, afterJD float64, geopos [3]float64, opts ReturnOptions) (*Chart, error) {
+	return findReturn(natal, body, afterJD, afterJD+maxReturnSearch, geopos, opts)
+}
+
+// findReturn finds the first return of body between startJD and endJD and
+// builds its chart
+func findReturn(natal *Chart, body int32, startJD, endJD float64, geopos [3]float64, opts ReturnOptions) (*Chart, error) {
+	defer natal.lockThread()()
+
+	c := &calculator{iflag: natal.Flags}
+	natalLon, _, err := c.lon(natal.JD, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Topocentric positions are searched for at the return location
+	applyChartConfig(natal.opts, geopos)
+
+	lonAt := func(jd float64) (float64, float64, error) { return c.lon(jd, body) }
+	if opts.Precessed && natal.Flags&FlagSidereal == 0 {
+		p0 := precessionInLongitude(natal.JD)
+		lonAt = func(jd float64) (float64, float64, error) {
+			lon, speed, err := c.lon(jd, body)
+			return normDeg(lon - (precessionInLongitude(jd) - p0)), speed, err
+		}
+	}
+
+	// Search a year at a time so that slow bodies stop at their first return
+	step := searchStep(body)
+	for t0 := startJD; t0 < endJD; t0 += TropicalYear {
+		t1 := t0 + TropicalYear
+		if t1 > endJD {
+			t1 = endJD
+		}
+		crossings, err := findCrossings(t0, t1, step, lonAt, []float64{natalLon})
+		if err != nil {
+			return nil, err
+		}
+		if len(crossings) == 0 {
+			continue
+		}
+
+		chartOpts := append(append([]ChartOption(nil), natal.opts...), opts.ChartOptions...)
+		chart, err := NewChartJD(crossings[0].jd, geopos, chartOpts...)
+		if err == nil {
+			err = c.warn
+		}
+		return chart, err
+	}
+
+	return nil, ErrNoReturn
+}
+
+// jdJ2000 is the Julian day of the epoch J2000
+const jdJ2000 = 2451545.0
+
+// precessionInLongitude returns the general precession in longitude since
+// J2000 in degrees (IAU 2006)
+func precessionInLongitude(jd float64) float64 {
+	t := (jd - jdJ2000) / 36525
+	return (5028.796195*t + 1.1054348*t*t) / 3600
+}
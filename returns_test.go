@@ -0,0 +1,89 @@
+// Go Swiss Ephemeris - Return Chart Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+var testReturnGeopos = [3]float64{2.3522, 48.8566, 0}
+
+func TestSolarReturn(t *testing.T) {
+	natal := testNatalChart(t)
+
+	ret, err := SolarReturn(natal, 2024, testReturnGeopos, ReturnOptions{})
+	if err != nil {
+		t.Fatalf("SolarReturn failed: %v", err)
+	}
+
+	date := Revjul(ret.JD, GregCal)
+	if date.Year != 2024 || date.Month != 7 || date.Day < 14 || date.Day > 16 {
+		t.Errorf("Unexpected solar return date %+v", date)
+	}
+	if d := angleDiff(ret.Bodies[0].Position.Lon, natal.Bodies[0].Position.Lon); math.Abs(d) > 1e-6 {
+		t.Errorf("Return Sun differs from natal Sun by %.8f°", d)
+	}
+	if ret.Geopos != testReturnGeopos {
+		t.Error("Return chart is not cast for the return location")
+	}
+	houses := HousesEx(ret.JD, 0, testReturnGeopos[1], testReturnGeopos[0], 'P')
+	if math.Abs(ret.Angles[Asc]-houses.Points[Asc]) > 1e-9 {
+		t.Error("Return houses differ from HousesEx at the return location")
+	}
+
+	// 34 years of precession move the return about 0.475° or 12 hours later
+	precessed, err := SolarReturn(natal, 2024, testReturnGeopos, ReturnOptions{Precessed: true})
+	if err != nil {
+		t.Fatalf("SolarReturn failed: %v", err)
+	}
+	if d := angleDiff(precessed.Bodies[0].Position.Lon, natal.Bodies[0].Position.Lon); math.Abs(d-0.475) > 0.01 {
+		t.Errorf("Precessed return Sun is %.4f° from natal Sun", d)
+	}
+	if precessed.JD <= ret.JD {
+		t.Error("Precessed return is not after the tropical return")
+	}
+}
+
+func TestLunarAndPlanetaryReturn(t *testing.T) {
+	natal := testNatalChart(t)
+	after := Julday(2024, 1, 1, 0.0, GregCal)
+
+	lunar, err := LunarReturn(natal, after, testReturnGeopos, ReturnOptions{})
+	if err != nil {
+		t.Fatalf("LunarReturn failed: %v", err)
+	}
+	if lunar.JD < after || lunar.JD > after+TropicalMonth {
+		t.Errorf("Lunar return at %.4f is not within a month after %.4f", lunar.JD, after)
+	}
+	if d := angleDiff(lunar.Bodies[1].Position.Lon, natal.Bodies[1].Position.Lon); math.Abs(d) > 1e-6 {
+		t.Errorf("Return Moon differs from natal Moon by %.8f°", d)
+	}
+
+	// Saturn returned to 22° Capricorn in early 2020
+	saturn, err := PlanetaryReturn(natal, Saturn, Julday(2010, 1, 1, 0.0, GregCal), testReturnGeopos, ReturnOptions{})
+	if err != nil {
+		t.Fatalf("PlanetaryReturn failed: %v", err)
+	}
+	if date := Revjul(saturn.JD, GregCal); date.Year != 2020 {
+		t.Errorf("Unexpected Saturn return date %+v", date)
+	}
+	if d := angleDiff(saturn.Bodies[6].Position.Lon, natal.Bodies[6].Position.Lon); math.Abs(d) > 1e-6 {
+		t.Errorf("Return Saturn differs from natal Saturn by %.8f°", d)
+	}
+}
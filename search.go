@@ -0,0 +1,195 @@
+// Go Swiss Ephemeris - Event Search Helpers
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"errors"
+	"math"
+)
+
+// Direction is the direction of motion of a body in longitude
+type Direction int
+
+const (
+	Direct     Direction = 1  // Increasing longitude
+	Retrograde Direction = -1 // Decreasing longitude
+)
+
+// String returns "direct" or "retrograde"
+func (d Direction) String() string {
+	if d == Retrograde {
+		return "retrograde"
+	}
+	return "direct"
+}
+
+// searchTolerance is the precision of event times found by root finding,
+// in days (about 10 ms)
+const searchTolerance = 1e-7
+
+// errInvalidRange is returned by searches whose end is not after the start
+var errInvalidRange = errors.New("swisseph: end of search range must be after its start")
+
+// searchStep returns the sampling step in days used to bracket events of a
+// body. It is small enough that longitude moves less than 90° per step and
+// that two stations never fall into one step.
+func searchStep(body int32) float64 {
+	switch body {
+	case Moon:
+		return 0.5
+	case TrueNode, OscuApog:
+		return 0.25
+	}
+	return 1.0
+}
+
+// calculator runs CalcUTArray with fixed flags and remembers the first
+// warning, so searches can go on after a fallback to Moshier and still
+// report it to the caller
+type calculator struct {
+	iflag int32
+	warn  error
+}
+
+// calc returns the position of body at jd; only hard errors are returned
+func (c *calculator) calc(jd float64, body int32) ([6]float64, error) {
+	xx, _, err := CalcUTArray(jd, body, c.iflag)
+	return xx, c.check(err)
+}
+
+// check remembers the first warning and returns err only if it is a failure
+func (c *calculator) check(err error) error {
+	if err == nil {
+		return nil
+	}
+	if !IsWarning(err) {
+		return err
+	}
+	if c.warn == nil {
+		c.warn = err
+	}
+	return nil
+}
+
+// lon returns the longitude and longitude speed of body at jd
+func (c *calculator) lon(jd float64, body int32) (float64, float64, error) {
+	xx, err := c.calc(jd, body)
+	return xx[0], xx[3], err
+}
+
+// findRoot finds a zero of f in [a, b] with Brent's method, given f(a) and
+// f(b) of opposite signs
+func findRoot(f func(float64) (float64, error), a, b, fa, fb float64) (float64, error) {
+	if fa == 0 {
+		return a, nil
+	}
+	if fb == 0 {
+		return b, nil
+	}
+
+	c, fc := a, fa
+	d := b - a
+	e := d
+
+	for i := 0; i < 100; i++ {
+		if (fb > 0) == (fc > 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol := 0.5 * searchTolerance
+		m := 0.5 * (c - b)
+		if math.Abs(m) <= tol || fb == 0 {
+			return b, nil
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			// Inverse quadratic interpolation or secant step
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				qa := fa / fc
+				r := fb / fc
+				p = s * (2*m*qa*(qa-r) - (b-a)*(r-1))
+				q = (qa - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			if 2*p < math.Min(3*m*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = m
+				e = m
+			}
+		} else {
+			d = m
+			e = m
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else if m > 0 {
+			b += tol
+		} else {
+			b -= tol
+		}
+
+		var err error
+		if fb, err = f(b); err != nil {
+			return b, err
+		}
+	}
+
+	return b, nil
+}
+
+// angleCrossed reports whether a signed angular distance went from d0 to d1
+// through zero rather than through ±180°
+func angleCrossed(d0, d1 float64) bool {
+	return (d0 < 0) != (d1 < 0) && math.Abs(d0) < 90 && math.Abs(d1) < 90
+}
+
+// angleDiff returns a - b normalized to [-180, 180] degrees
+func angleDiff(a, b float64) float64 {
+	return math.Remainder(a-b, 360)
+}
+
+// normDeg normalizes degrees to [0, 360) without a cgo call
+func normDeg(x float64) float64 {
+	x = math.Mod(x, 360)
+	if x < 0 {
+		x += 360
+	}
+	if x >= 360 {
+		x = 0
+	}
+	return x
+}
@@ -0,0 +1,244 @@
+// Go Swiss Ephemeris - Event Search Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFindIngresses_Sun(t *testing.T) {
+	start := Julday(2024, 1, 1, 0.0, GregCal)
+	end := Julday(2025, 1, 1, 0.0, GregCal)
+
+	ingresses, err := FindIngresses(start, end, Sun, FlagMoseph, nil)
+	if err != nil {
+		t.Fatalf("FindIngresses failed: %v", err)
+	}
+	if len(ingresses) != 12 {
+		t.Fatalf("Expected 12 solar ingresses in 2024, got %d", len(ingresses))
+	}
+
+	equinox, err := SolcrossUT(0, start, FlagMoseph)
+	if err != nil {
+		t.Fatalf("SolcrossUT failed: %v", err)
+	}
+	for _, ing := range ingresses {
+		if ing.Boundary == 0 {
+			if math.Abs(ing.JD-equinox)*86400 > 1 {
+				t.Errorf("Aries ingress at %.8f, SolcrossUT gives %.8f", ing.JD, equinox)
+			}
+			if ing.To != 0 || ing.From != 11 || ing.Direction != Direct {
+				t.Errorf("Unexpected Aries ingress %+v", ing)
+			}
+		}
+	}
+}
+
+func TestFindIngresses_MarsRetrograde(t *testing.T) {
+	start := Julday(2024, 10, 1, 0.0, GregCal)
+	end := Julday(2025, 5, 1, 0.0, GregCal)
+
+	ingresses, err := FindIngresses(start, end, Mars, FlagMoseph, nil)
+	if err != nil {
+		t.Fatalf("FindIngresses failed: %v", err)
+	}
+
+	// Mars entered Leo on 2024-11-04, re-entered Cancer retrograde on
+	// 2025-01-06 and entered Leo again on 2025-04-18
+	want := []struct {
+		day       float64
+		to        int
+		direction Direction
+	}{
+		{Julday(2024, 11, 4, 12.0, GregCal), 4, Direct},
+		{Julday(2025, 1, 6, 12.0, GregCal), 3, Retrograde},
+		{Julday(2025, 4, 18, 12.0, GregCal), 4, Direct},
+	}
+	if len(ingresses) != len(want) {
+		t.Fatalf("Expected %d ingresses, got %d: %+v", len(want), len(ingresses), ingresses)
+	}
+	for i, w := range want {
+		ing := ingresses[i]
+		if math.Abs(ing.JD-w.day) > 1 || ing.To != w.to || ing.Direction != w.direction {
+			t.Errorf("Ingress %d = %+v, expected sign %d %s around JD %.1f", i, ing, w.to, w.direction, w.day)
+		}
+	}
+}
+
+func TestFindStations_Mars(t *testing.T) {
+	start := Julday(2024, 10, 1, 0.0, GregCal)
+	end := Julday(2025, 5, 1, 0.0, GregCal)
+
+	stations, err := FindStations(start, end, Mars, FlagMoseph)
+	if err != nil {
+		t.Fatalf("FindStations failed: %v", err)
+	}
+
+	// Mars stationed retrograde on 2024-12-06 at 6° Leo and direct on
+	// 2025-02-24 at 17° Cancer
+	if len(stations) != 2 {
+		t.Fatalf("Expected 2 stations, got %d: %+v", len(stations), stations)
+	}
+	if st := stations[0]; st.Type != StationRetrograde || math.Abs(st.JD-Julday(2024, 12, 6, 12.0, GregCal)) > 1 ||
+		math.Abs(st.Position.Lon-126) > 1 {
+		t.Errorf("Unexpected retrograde station %+v", st)
+	}
+	if st := stations[1]; st.Type != StationDirect || math.Abs(st.JD-Julday(2025, 2, 24, 12.0, GregCal)) > 1 ||
+		math.Abs(st.Position.Lon-107) > 1 {
+		t.Errorf("Unexpected direct station %+v", st)
+	}
+
+	// The speed at the station is zero to sub-second precision
+	for _, st := range stations {
+		before := CalcUT(st.JD-1.0/86400, Mars, FlagMoseph|FlagSpeed).Data[3]
+		after := CalcUT(st.JD+1.0/86400, Mars, FlagMoseph|FlagSpeed).Data[3]
+		if (before < 0) == (after < 0) {
+			t.Errorf("Speed does not change sign within a second of %.8f", st.JD)
+		}
+	}
+}
+
+func TestFindLatitudeStations_Moon(t *testing.T) {
+	start := Julday(2024, 1, 1, 0.0, GregCal)
+	end := Julday(2024, 2, 1, 0.0, GregCal)
+
+	stations, err := FindLatitudeStations(start, end, Moon, FlagMoseph)
+	if err != nil {
+		t.Fatalf("FindLatitudeStations failed: %v", err)
+	}
+
+	// The Moon reaches its extreme latitudes about every 13.6 days
+	if len(stations) < 2 || len(stations) > 3 {
+		t.Fatalf("Expected 2 or 3 latitude extremes in a month, got %d", len(stations))
+	}
+	for _, st := range stations {
+		if math.Abs(st.Position.Lat) < 4.5 {
+			t.Errorf("Latitude extreme %.4f° is smaller than expected", st.Position.Lat)
+		}
+		if (st.Type == StationMaxLatitude) != (st.Position.Lat > 0) {
+			t.Errorf("Station type %s does not match latitude %.4f°", st.Type, st.Position.Lat)
+		}
+	}
+}
+
+func TestFindAspects_TripleConjunction(t *testing.T) {
+	start := Julday(1980, 11, 1, 0.0, GregCal)
+	end := Julday(1981, 10, 1, 0.0, GregCal)
+
+	events, err := FindAspects(start, end, BodyPoint(Jupiter), BodyPoint(Saturn), []float64{0}, 1, FlagMoseph)
+	if err != nil {
+		t.Fatalf("FindAspects failed: %v", err)
+	}
+
+	// Jupiter and Saturn were conjunct three times, on 1980-12-31,
+	// 1981-03-04 and 1981-07-24
+	want := []float64{
+		Julday(1980, 12, 31, 0.0, GregCal),
+		Julday(1981, 3, 4, 0.0, GregCal),
+		Julday(1981, 7, 24, 0.0, GregCal),
+	}
+	var exact []AspectEvent
+	inOrb := false
+	for _, ev := range events {
+		switch ev.Type {
+		case AspectExact:
+			exact = append(exact, ev)
+			if math.Abs(angleDiff(ev.Lon1, ev.Lon2)) > 1e-5 {
+				t.Errorf("Conjunction at %.6f is not exact: %.6f° vs %.6f°", ev.JD, ev.Lon1, ev.Lon2)
+			}
+		case AspectEnter:
+			if inOrb {
+				t.Errorf("Entering orb twice at %.6f", ev.JD)
+			}
+			inOrb = true
+		case AspectLeave:
+			if !inOrb {
+				t.Errorf("Leaving orb without entering at %.6f", ev.JD)
+			}
+			inOrb = false
+		}
+	}
+	if inOrb {
+		t.Error("Search ended within orb")
+	}
+	if len(exact) != len(want) {
+		t.Fatalf("Expected %d conjunctions, got %d", len(want), len(exact))
+	}
+	for i, ev := range exact {
+		if math.Abs(ev.JD-want[i]) > 1.5 {
+			t.Errorf("Conjunction %d at %.4f, expected about %.4f", i, ev.JD, want[i])
+		}
+	}
+	if exact[1].Direction != Retrograde {
+		t.Error("Second conjunction should happen with decreasing separation")
+	}
+}
+
+func TestFindAspects_Points(t *testing.T) {
+	start := Julday(2024, 3, 1, 0.0, GregCal)
+	end := Julday(2024, 4, 1, 0.0, GregCal)
+
+	// The Sun conjunct a fixed longitude of 0° is the vernal equinox
+	events, err := FindAspects(start, end, BodyPoint(Sun), FixedPoint(0), []float64{0}, 0, FlagMoseph)
+	if err != nil {
+		t.Fatalf("FindAspects failed: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(events))
+	}
+	equinox, err := SolcrossUT(0, start, FlagMoseph)
+	if err != nil {
+		t.Fatalf("SolcrossUT failed: %v", err)
+	}
+	if math.Abs(events[0].JD-equinox)*86400 > 1 {
+		t.Errorf("Equinox at %.8f, SolcrossUT gives %.8f", events[0].JD, equinox)
+	}
+
+	// The MC passes the Sun once a day
+	events, err = FindAspects(start, start+3, AnglePoint(MC, 51.5, 0), BodyPoint(Sun), []float64{0}, 0, FlagMoseph)
+	if err != nil {
+		t.Fatalf("FindAspects failed: %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 culminations of the Sun, got %d", len(events))
+	}
+	for _, ev := range events {
+		// Local noon at Greenwich is within 15 minutes of 12:00 UT
+		if frac := ev.JD + 0.5 - math.Floor(ev.JD+0.5); math.Abs(frac-0.5) > 0.25/24 {
+			t.Errorf("Culmination at %.6f is not near noon", ev.JD)
+		}
+	}
+
+	if _, err := FindAspects(start, end, AnglePoint(Nascmc, 0, 0), BodyPoint(Sun), nil, 0, FlagMoseph); err == nil {
+		t.Error("Expected an error for an invalid angle")
+	}
+}
+
+func TestHarmonicAspects(t *testing.T) {
+	got := HarmonicAspects(6)
+	want := []float64{0, 60, 120, 180}
+	if len(got) != len(want) {
+		t.Fatalf("HarmonicAspects(6) = %v", got)
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("HarmonicAspects(6) = %v, expected %v", got, want)
+		}
+	}
+}
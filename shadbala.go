@@ -0,0 +1,413 @@
+// Go Swiss Ephemeris - Shadbala
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import "math"
+
+// Virupas is the number of virupas in a rupa, the unit of Shadbala
+const Virupas = 60.0
+
+// kaliYugaEpoch is the civil day number (Julian day at noon) of the start of
+// the Kali Yuga, Friday 18 February 3102 BC, from which Abda and Masa bala
+// count years and months of 360 and 30 days
+const kaliYugaEpoch = 588466
+
+// Constants of the Shadbala of the Sun to Saturn, indexed by Graha
+var (
+	// deepExaltation are the sidereal longitudes of deep exaltation
+	deepExaltation = [7]float64{10, 33, 298, 165, 95, 357, 200}
+
+	// moolatrikona are the sidereal longitude ranges of the moolatrikonas
+	moolatrikona = [7][2]float64{{120, 140}, {33, 60}, {0, 12}, {165, 170}, {240, 250}, {180, 195}, {300, 320}}
+
+	// naisargikaBala is the natural strength in virupas
+	naisargikaBala = [7]float64{60, 60 * 6 / 7.0, 60 * 2 / 7.0, 60 * 3 / 7.0, 60 * 4 / 7.0, 60 * 5 / 7.0, 60 / 7.0}
+
+	// requiredRupas is the minimum Shadbala in rupas
+	requiredRupas = [7]float64{6.5, 6, 5, 7, 6.5, 5.5, 5}
+
+	// naturalRelations are the natural friendships of the grahas: 1 for a
+	// friend, 0 for a neutral and -1 for an enemy
+	naturalRelations = [7][7]int{
+		{0, 1, 1, 0, 1, -1, -1},  // Sun
+		{1, 0, 0, 1, 0, 0, 0},    // Moon
+		{1, 1, 0, -1, 1, 0, 0},   // Mars
+		{1, -1, 0, 0, 0, 1, 0},   // Mercury
+		{1, 1, 1, -1, 0, -1, 0},  // Jupiter
+		{-1, -1, 0, 1, 0, 0, 1},  // Venus
+		{-1, -1, -1, 1, 0, 1, 0}, // Saturn
+	}
+)
+
+// saptavargas are the seven divisions of Saptavargaja bala
+var saptavargas = [7]int{1, 2, 3, 7, 9, 12, 30}
+
+// saptavargajaBala are the virupas of a graha in the sign of a great enemy,
+// enemy, neutral, friend and great friend
+var saptavargajaBala = [5]float64{2, 4, 10, 15, 20}
+
+// horaLords are the lords of the hours in Chaldean order
+var horaLords = [7]Graha{GrahaSun, GrahaVenus, GrahaMercury, GrahaMoon, GrahaSaturn, GrahaJupiter, GrahaMars}
+
+// Shadbala is the six-fold strength of a graha in virupas
+type Shadbala struct {
+	Graha Graha // Graha from the Sun to Saturn
+
+	// Sthana bala, positional strength
+	Uchcha       float64 // Distance from the point of debilitation
+	Saptavargaja float64 // Dignity in the D1, D2, D3, D7, D9, D12 and D30 charts
+	Ojayugma     float64 // Odd or even sign in the D1 and D9 charts
+	Kendra       float64 // House from the Ascendant
+	Drekkana     float64 // Decanate of the sign
+
+	Dig float64 // Dig bala, directional strength
+
+	// Kala bala, temporal strength
+	Nathonnatha float64 // Time from noon or midnight
+	Paksha      float64 // Elongation of the Moon
+	Tribhaga    float64 // Third of the day or night
+	Abda        float64 // Lord of the year
+	Masa        float64 // Lord of the month
+	Vara        float64 // Lord of the weekday
+	Hora        float64 // Lord of the hour
+	Ayana       float64 // Declination
+
+	Chesta     float64 // Chesta bala, motional strength
+	Naisargika float64 // Naisargika bala, natural strength
+	Drik       float64 // Drik bala, aspects received
+}
+
+// Sthana returns the Sthana bala
+func (s Shadbala) Sthana() float64 {
+	return s.Uchcha + s.Saptavargaja + s.Ojayugma + s.Kendra + s.Drekkana
+}
+
+// Kala returns the Kala bala
+func (s Shadbala) Kala() float64 {
+	return s.Nathonnatha + s.Paksha + s.Tribhaga + s.Abda + s.Masa + s.Vara + s.Hora + s.Ayana
+}
+
+// Total returns the Shadbala in virupas
+func (s Shadbala) Total() float64 {
+	return s.Sthana() + s.Dig + s.Kala() + s.Chesta + s.Naisargika + s.Drik
+}
+
+// Rupas returns the Shadbala in rupas
+func (s Shadbala) Rupas() float64 {
+	return s.Total() / Virupas
+}
+
+// Ratio returns the Shadbala relative to the minimum required of the graha;
+// a graha with a ratio of at least 1 is strong
+func (s Shadbala) Ratio() float64 {
+	return s.Rupas() / requiredRupas[s.Graha]
+}
+
+// ChartShadbala returns the Shadbala of the Sun to Saturn in a chart,
+// indexed by Graha, after Brihat Parashara Hora Shastra. The chart need not
+// contain the grahas; use a sidereal chart built with WithSidereal. Kendra
+// bala uses the houses of the chart and the day, the night and the hours
+// run from the sunrise before the chart, found with RiseTrans and
+// BitHinduRising.
+//
+// The Chesta bala of the Sun is its Ayana bala and that of the Moon its
+// Paksha bala; for the other grahas it is a third of the chesta kendra, the
+// distance of the sighrocca from the mean of the true and mean longitudes,
+// with the heliocentric longitude standing in for the mean planet of Mars
+// to Saturn and for the sighrocca of Mercury and Venus. Mercury is always a
+// benefic, and the special aspects of Mars, Jupiter and Saturn count in
+// full for Drik bala. Yuddha bala (planetary war) is not included.
+//
+// The error matches ErrCircumpolar if the Sun does not rise or set.
+func ChartShadbala(chart *Chart) ([7]Shadbala, error) {
+	defer chart.lockThread()()
+
+	var out [7]Shadbala
+	flags := chart.Flags | FlagSpeed
+	c := &calculator{iflag: flags}
+	equ := &calculator{iflag: (flags | FlagEquatorial) &^ FlagSidereal}
+	hel := &calculator{iflag: (flags | FlagHelctr) &^ FlagTopoctr}
+
+	var lons, helio [7]float64
+	var decl [7]float64
+	var sunRA float64
+	for g := GrahaSun; g <= GrahaSaturn; g++ {
+		lon, _, err := c.grahaLon(chart.JD, g)
+		if err != nil {
+			return out, err
+		}
+		lons[g] = lon
+		xx, err := equ.calc(chart.JD, g.Body())
+		if err != nil {
+			return out, err
+		}
+		decl[g] = xx[1]
+		if g == GrahaSun {
+			sunRA = xx[0]
+		}
+		if g >= GrahaMars {
+			if helio[g], _, err = hel.lon(chart.JD, g.Body()); err != nil {
+				return out, err
+			}
+		}
+	}
+
+	lords, err := chartTimeLords(chart, c)
+	if err != nil {
+		return out, err
+	}
+
+	elong := normDeg(lons[GrahaMoon] - lons[GrahaSun])
+	waxing := elong < 180
+	sunDist := math.Abs(angleDiff(lons[GrahaMoon], lons[GrahaSun]))
+	hourAngle := math.Abs(angleDiff(chart.Angles[ARMC], sunRA))
+
+	for g := GrahaSun; g <= GrahaSaturn; g++ {
+		s := Shadbala{Graha: g, Naisargika: naisargikaBala[g]}
+		lon := lons[g]
+
+		// Sthana bala
+		s.Uchcha = (180 - math.Abs(angleDiff(lon, deepExaltation[g]))) / 3
+		for _, d := range saptavargas {
+			s.Saptavargaja += dignityBala(g, Varga(lon, d, VargaParashari), d == 1, lons)
+		}
+		for _, d := range []int{1, 9} {
+			sign := int(Varga(lon, d, VargaParashari) / 30)
+			if (sign%2 == 1) == (g == GrahaMoon || g == GrahaVenus) {
+				s.Ojayugma += 15
+			}
+		}
+		switch int(cuspHousePos(lon, chart.Cusps)) % 3 {
+		case 1:
+			s.Kendra = 60
+		case 2:
+			s.Kendra = 30
+		default:
+			s.Kendra = 15
+		}
+		decanate := int(math.Mod(lon, 30) / 10)
+		switch {
+		case decanate == 0 && (g == GrahaSun || g == GrahaMars || g == GrahaJupiter),
+			decanate == 1 && (g == GrahaMercury || g == GrahaSaturn),
+			decanate == 2 && (g == GrahaMoon || g == GrahaVenus):
+			s.Drekkana = 15
+		}
+
+		// Dig bala from the point of strength
+		strong := chart.Angles[MC] // Sun and Mars
+		switch g {
+		case GrahaJupiter, GrahaMercury:
+			strong = chart.Angles[Asc]
+		case GrahaMoon, GrahaVenus:
+			strong = chart.Angles[MC] + 180
+		case GrahaSaturn:
+			strong = chart.Angles[Asc] + 180
+		}
+		s.Dig = (180 - math.Abs(angleDiff(lon, strong))) / 3
+
+		// Kala bala
+		switch g {
+		case GrahaSun, GrahaJupiter, GrahaVenus:
+			s.Nathonnatha = (180 - hourAngle) / 3
+		case GrahaMoon, GrahaMars, GrahaSaturn:
+			s.Nathonnatha = hourAngle / 3
+		default:
+			s.Nathonnatha = 60
+		}
+		switch g {
+		case GrahaMoon, GrahaMercury, GrahaJupiter, GrahaVenus:
+			s.Paksha = sunDist / 3
+		default:
+			s.Paksha = (180 - sunDist) / 3
+		}
+		if g == GrahaJupiter || g == lords.tribhaga {
+			s.Tribhaga = 60
+		}
+		if g == lords.abda {
+			s.Abda = 15
+		}
+		if g == lords.masa {
+			s.Masa = 30
+		}
+		if g == lords.vara {
+			s.Vara = 45
+		}
+		if g == lords.hora {
+			s.Hora = 60
+		}
+		switch g {
+		case GrahaMoon, GrahaSaturn:
+			s.Ayana = (24 - decl[g]) / 48 * 60
+		case GrahaMercury:
+			s.Ayana = (24 + math.Abs(decl[g])) / 48 * 60
+		default:
+			s.Ayana = (24 + decl[g]) / 48 * 60
+		}
+		if g == GrahaSun {
+			s.Ayana *= 2
+		}
+
+		// Chesta bala
+		switch g {
+		case GrahaSun:
+			s.Chesta = s.Ayana
+		case GrahaMoon:
+			s.Chesta = s.Paksha
+		case GrahaMercury, GrahaVenus:
+			s.Chesta = chestaKendra(helio[g], lons[GrahaSun], lon) / 3
+		default:
+			s.Chesta = chestaKendra(lons[GrahaSun], helio[g], lon) / 3
+		}
+
+		// Drik bala
+		for a := GrahaSun; a <= GrahaSaturn; a++ {
+			if a == g {
+				continue
+			}
+			d := drishti(a, normDeg(lon-lons[a]))
+			switch a {
+			case GrahaMercury, GrahaJupiter, GrahaVenus:
+				s.Drik += d / 4
+			case GrahaMoon:
+				if waxing {
+					s.Drik += d / 4
+				} else {
+					s.Drik -= d / 4
+				}
+			default:
+				s.Drik -= d / 4
+			}
+		}
+
+		out[g] = s
+	}
+
+	return out, c.warn
+}
+
+// dignityBala returns the Saptavargaja bala of a graha at the longitude of a
+// divisional chart; rasi is true for the D1 chart, where moolatrikona counts
+func dignityBala(g Graha, vargaLon float64, rasi bool, lons [7]float64) float64 {
+	if rasi && vargaLon >= moolatrikona[g][0] && vargaLon < moolatrikona[g][1] {
+		return 45
+	}
+	lord := signLords[int(vargaLon/30)]
+	if lord == g {
+		return 30
+	}
+	temporal := -1
+	switch (int(lons[lord]/30) - int(lons[g]/30) + 12) % 12 {
+	case 1, 2, 3, 9, 10, 11:
+		temporal = 1
+	}
+	return saptavargajaBala[naturalRelations[g][lord]+temporal+2]
+}
+
+// chestaKendra returns the distance in degrees (0-180) of the sighrocca from
+// the mean of the mean and true longitudes
+func chestaKendra(sighrocca, mean, trueLon float64) float64 {
+	return math.Abs(angleDiff(sighrocca, mean+angleDiff(trueLon, mean)/2))
+}
+
+// drishti returns the aspect in virupas of a graha on a point d degrees
+// further along the zodiac
+func drishti(g Graha, d float64) float64 {
+	switch {
+	case g == GrahaMars && (d >= 90 && d < 120 || d >= 210 && d < 240),
+		g == GrahaJupiter && (d >= 120 && d < 150 || d >= 240 && d < 270),
+		g == GrahaSaturn && (d >= 60 && d < 90 || d >= 270 && d < 300):
+		return 60
+	case d < 30 || d >= 300:
+		return 0
+	case d < 60:
+		return (d - 30) / 2
+	case d < 90:
+		return d - 60 + 15
+	case d < 120:
+		return (120-d)/2 + 30
+	case d < 150:
+		return 150 - d
+	case d < 180:
+		return (d - 150) * 2
+	}
+	return (300 - d) / 2
+}
+
+// timeLords are the lords of the year, month, weekday and hour of a moment
+// and the lord of its third of the day or night
+type timeLords struct {
+	abda, masa, vara, hora, tribhaga Graha
+}
+
+// chartTimeLords returns the time lords of the moment of a chart, reckoned
+// from the sunrise before it
+func chartTimeLords(chart *Chart, c *calculator) (timeLords, error) {
+	ephe := ephemerisBits(c.iflag)
+	riseTrans := func(jd float64, rsmi int32) (float64, error) {
+		result, err := RiseTransE(jd, Sun, "", ephe, rsmi|BitHinduRising, chart.Geopos, 0, 0)
+		return result.Time, c.check(err)
+	}
+
+	sunrise, err := riseTrans(chart.JD-2, CalcRise)
+	if err != nil {
+		return timeLords{}, err
+	}
+	for {
+		next, err := riseTrans(sunrise+0.01, CalcRise)
+		if err != nil {
+			return timeLords{}, err
+		}
+		if next > chart.JD {
+			break
+		}
+		sunrise = next
+	}
+	sunset, err := riseTrans(sunrise, CalcSet)
+	if err != nil {
+		return timeLords{}, err
+	}
+
+	var v timeLords
+	if chart.JD < sunset {
+		third := int(3 * (chart.JD - sunrise) / (sunset - sunrise))
+		v.tribhaga = [3]Graha{GrahaMercury, GrahaSun, GrahaSaturn}[min(third, 2)]
+	} else {
+		nextSunrise, err := riseTrans(sunset, CalcRise)
+		if err != nil {
+			return timeLords{}, err
+		}
+		third := int(3 * (chart.JD - sunset) / (nextSunrise - sunset))
+		v.tribhaga = [3]Graha{GrahaMoon, GrahaVenus, GrahaMars}[min(third, 2)]
+	}
+
+	// Weekday lords of the civil days at local mean time, 0 = Sunday
+	day := int(math.Floor(sunrise + 0.5 + chart.Geopos[0]/360))
+	weekday := func(day int) Graha { return Graha((day + 1) % 7) }
+	elapsed := day - kaliYugaEpoch
+	v.abda = weekday(day - elapsed%360)
+	v.masa = weekday(day - elapsed%30)
+	v.vara = weekday(day)
+
+	hour := int((chart.JD - sunrise) * 24)
+	for i, l := range horaLords {
+		if l == v.vara {
+			v.hora = horaLords[(i+hour)%7]
+		}
+	}
+	return v, nil
+}
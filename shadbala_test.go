@@ -0,0 +1,135 @@
+// Go Swiss Ephemeris - Shadbala Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDrishti(t *testing.T) {
+	tests := []struct {
+		g        Graha
+		d        float64
+		expected float64
+	}{
+		{GrahaSun, 10, 0},
+		{GrahaSun, 45, 7.5},
+		{GrahaSun, 90, 45},
+		{GrahaSun, 120, 30},
+		{GrahaSun, 180, 60},
+		{GrahaSun, 240, 30},
+		{GrahaSun, 330, 0},
+		{GrahaMars, 100, 60},
+		{GrahaMars, 220, 60},
+		{GrahaJupiter, 130, 60},
+		{GrahaJupiter, 250, 60},
+		{GrahaSaturn, 70, 60},
+		{GrahaSaturn, 280, 60},
+	}
+	for _, test := range tests {
+		if got := drishti(test.g, test.d); math.Abs(got-test.expected) > 1e-9 {
+			t.Errorf("drishti(%s, %.0f) = %.2f, expected %.2f", test.g, test.d, got, test.expected)
+		}
+	}
+}
+
+func TestChestaKendra(t *testing.T) {
+	// Superior planet at opposition and conjunction
+	if ck := chestaKendra(0, 180, 180); math.Abs(ck-180) > 1e-9 {
+		t.Errorf("Chesta kendra at opposition %.2f, expected 180", ck)
+	}
+	if ck := chestaKendra(0, 0, 0); ck != 0 {
+		t.Errorf("Chesta kendra at conjunction %.2f, expected 0", ck)
+	}
+	// Inferior planet at inferior conjunction, across 0° Aries
+	if ck := chestaKendra(185, 5, 5); math.Abs(ck-180) > 1e-9 {
+		t.Errorf("Chesta kendra at inferior conjunction %.2f, expected 180", ck)
+	}
+}
+
+func TestDignityBala(t *testing.T) {
+	var lons [7]float64
+	lons[GrahaSun] = 125
+	if b := dignityBala(GrahaSun, 125, true, lons); b != 45 {
+		t.Errorf("Sun in its moolatrikona: %.0f virupas, expected 45", b)
+	}
+	if b := dignityBala(GrahaSun, 145, true, lons); b != 30 {
+		t.Errorf("Sun in its own sign: %.0f virupas, expected 30", b)
+	}
+	if b := dignityBala(GrahaSun, 125, false, lons); b != 30 {
+		t.Errorf("Sun in Leo in a varga: %.0f virupas, expected 30", b)
+	}
+
+	// Mars in Cancer with the Moon in Gemini, a natural and temporal friend
+	lons[GrahaMars], lons[GrahaMoon] = 100, 70
+	if b := dignityBala(GrahaMars, 100, true, lons); b != 20 {
+		t.Errorf("Mars in Cancer: %.0f virupas, expected 20", b)
+	}
+	// Saturn in Leo with the Sun in Leo, a natural and temporal enemy
+	lons[GrahaSaturn] = 130
+	if b := dignityBala(GrahaSaturn, 130, true, lons); b != 2 {
+		t.Errorf("Saturn in Leo: %.0f virupas, expected 2", b)
+	}
+}
+
+func TestChartShadbala(t *testing.T) {
+	natal := testNatalChart(t, WithSidereal(SidmLahiri))
+
+	bala, err := ChartShadbala(natal)
+	if err != nil {
+		t.Fatalf("ChartShadbala failed: %v", err)
+	}
+
+	lords := map[string]int{}
+	for g, s := range bala {
+		if s.Graha != Graha(g) || s.Naisargika != naisargikaBala[g] {
+			t.Errorf("Unexpected Shadbala of %s %+v", Graha(g), s)
+		}
+		for name, v := range map[string]float64{"Uchcha": s.Uchcha, "Dig": s.Dig, "Nathonnatha": s.Nathonnatha, "Paksha": s.Paksha} {
+			if v < 0 || v > 60 {
+				t.Errorf("%s bala of %s is %.2f", name, Graha(g), v)
+			}
+		}
+		if s.Saptavargaja < 7*2 || s.Saptavargaja > 45+6*30 || s.Kendra < 15 || s.Kendra > 60 {
+			t.Errorf("Sthana bala of %s %+v", Graha(g), s)
+		}
+		if s.Chesta < 0 || s.Chesta > 120 || s.Ayana < 0 || s.Ayana > 120 {
+			t.Errorf("Chesta bala %.2f and Ayana bala %.2f of %s", s.Chesta, s.Ayana, Graha(g))
+		}
+		if math.Abs(s.Rupas()*Virupas-s.Total()) > 1e-9 || math.Abs(s.Sthana()+s.Dig+s.Kala()+s.Chesta+s.Naisargika+s.Drik-s.Total()) > 1e-9 {
+			t.Errorf("Shadbala of %s does not add up", Graha(g))
+		}
+		for name, v := range map[string]float64{"abda": s.Abda, "masa": s.Masa, "vara": s.Vara, "hora": s.Hora} {
+			if v > 0 {
+				lords[name]++
+			}
+		}
+	}
+	for _, name := range []string{"abda", "masa", "vara", "hora"} {
+		if lords[name] != 1 {
+			t.Errorf("%d lords of the %s", lords[name], name)
+		}
+	}
+	if bala[GrahaJupiter].Tribhaga != 60 || bala[GrahaMercury].Nathonnatha != 60 {
+		t.Error("Jupiter must have Tribhaga bala and Mercury Nathonnatha bala")
+	}
+	if bala[GrahaSun].Chesta != bala[GrahaSun].Ayana || bala[GrahaMoon].Chesta != bala[GrahaMoon].Paksha {
+		t.Error("Chesta bala of the luminaries")
+	}
+}
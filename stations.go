@@ -0,0 +1,123 @@
+// Go Swiss Ephemeris - Stations
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+// StationType is the kind of a station
+type StationType int
+
+const (
+	StationRetrograde  StationType = iota // Longitude speed turns negative
+	StationDirect                         // Longitude speed turns positive
+	StationMaxLatitude                    // Latitude (or declination) speed turns negative
+	StationMinLatitude                    // Latitude (or declination) speed turns positive
+)
+
+// String returns the name of the station type
+func (s StationType) String() string {
+	switch s {
+	case StationRetrograde:
+		return "stationary retrograde"
+	case StationDirect:
+		return "stationary direct"
+	case StationMaxLatitude:
+		return "maximum latitude"
+	case StationMinLatitude:
+		return "minimum latitude"
+	}
+	return "unknown"
+}
+
+// Station is a moment when the speed of a body in one coordinate is zero
+type Station struct {
+	JD       float64     // Julian day (UT) of the station
+	Body     int32       // Planet number
+	Type     StationType // Kind of station
+	Position Position    // Position of the body at the station
+}
+
+// FindStations finds all stationary retrograde and stationary direct moments
+// of a body between startJD and endJD (UT), in time order. Any body accepted
+// by CalcUT can be used, including asteroids and the true node. With
+// FlagEquatorial, stations in right ascension are found instead.
+//
+// If a calculation fell back to another ephemeris, the stations are returned
+// together with a warning error (see IsWarning).
+func FindStations(startJD, endJD float64, body int32, flags int32) ([]Station, error) {
+	return findSpeedZeros(startJD, endJD, body, flags, 3, StationRetrograde, StationDirect)
+}
+
+// FindLatitudeStations finds all extremes of the ecliptic latitude of a body
+// (or of its declination with FlagEquatorial) between startJD and endJD (UT),
+// in time order.
+func FindLatitudeStations(startJD, endJD float64, body int32, flags int32) ([]Station, error) {
+	return findSpeedZeros(startJD, endJD, body, flags, 4, StationMaxLatitude, StationMinLatitude)
+}
+
+// findSpeedZeros brackets sign changes of the speed xx[index] and refines
+// them with findRoot
+func findSpeedZeros(startJD, endJD float64, body int32, flags int32, index int, turnNegative, turnPositive StationType) ([]Station, error) {
+	if endJD <= startJD {
+		return nil, errInvalidRange
+	}
+
+	c := &calculator{iflag: (flags | FlagSpeed) &^ FlagXYZ}
+	speedAt := func(jd float64) (float64, error) {
+		xx, err := c.calc(jd, body)
+		return xx[index], err
+	}
+
+	var stations []Station
+	step := searchStep(body)
+
+	t0 := startJD
+	s0, err := speedAt(t0)
+	if err != nil {
+		return nil, err
+	}
+	for t0 < endJD {
+		t1 := t0 + step
+		if t1 > endJD {
+			t1 = endJD
+		}
+		s1, err := speedAt(t1)
+		if err != nil {
+			return nil, err
+		}
+
+		if (s0 < 0) != (s1 < 0) {
+			jd, err := findRoot(speedAt, t0, t1, s0, s1)
+			if err != nil {
+				return nil, err
+			}
+			xx, err := c.calc(jd, body)
+			if err != nil {
+				return nil, err
+			}
+
+			st := Station{JD: jd, Body: body, Type: turnPositive, Position: NewPosition(xx, c.iflag)}
+			if s1 < 0 {
+				st.Type = turnNegative
+			}
+			stations = append(stations, st)
+		}
+
+		t0, s0 = t1, s1
+	}
+
+	return stations, c.warn
+}
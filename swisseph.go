@@ -18,7 +18,6 @@
 package swisseph
 
 import (
-	"fmt"
 	"unsafe"
 
 	_ "github.com/tejzpr/go-swisseph/swisseph"
@@ -132,9 +131,17 @@ func Calc(tjdEt float64, ipl int32, iflag int32) CalcResult {
 		result.Data[i] = float64(xx[i])
 	}
 
+	result.Fallback = ephemerisFallback(iflag, result.Flag, result.Error)
+
 	return result
 }
 
+// CalcE is Calc returning an error for failures, warnings and fallbacks to Moshier
+func CalcE(tjdEt float64, ipl int32, iflag int32) (CalcResult, error) {
+	result := Calc(tjdEt, ipl, iflag)
+	return result, calcError("swe_calc", iflag, result.Flag, result.Error)
+}
+
 // CalcUT calculates planetary positions for a given Julian day (universal time)
 func CalcUT(tjdUt float64, ipl int32, iflag int32) CalcResult {
 	var xx [6]C.double
@@ -158,9 +165,17 @@ func CalcUT(tjdUt float64, ipl int32, iflag int32) CalcResult {
 		result.Data[i] = float64(xx[i])
 	}
 
+	result.Fallback = ephemerisFallback(iflag, result.Flag, result.Error)
+
 	return result
 }
 
+// CalcUTE is CalcUT returning an error for failures, warnings and fallbacks to Moshier
+func CalcUTE(tjdUt float64, ipl int32, iflag int32) (CalcResult, error) {
+	result := CalcUT(tjdUt, ipl, iflag)
+	return result, calcError("swe_calc_ut", iflag, result.Flag, result.Error)
+}
+
 // CalcPctr calculates planetocentric positions
 func CalcPctr(tjdEt float64, ipl int32, iplctr int32, iflag int32) CalcResult {
 	var xx [6]C.double
@@ -185,9 +200,17 @@ func CalcPctr(tjdEt float64, ipl int32, iplctr int32, iflag int32) CalcResult {
 		result.Data[i] = float64(xx[i])
 	}
 
+	result.Fallback = ephemerisFallback(iflag, result.Flag, result.Error)
+
 	return result
 }
 
+// CalcPctrE is CalcPctr returning an error for failures, warnings and fallbacks to Moshier
+func CalcPctrE(tjdEt float64, ipl int32, iplctr int32, iflag int32) (CalcResult, error) {
+	result := CalcPctr(tjdEt, ipl, iplctr, iflag)
+	return result, calcError("swe_calc_pctr", iflag, result.Flag, result.Error)
+}
+
 // Julday calculates the Julian day number from a calendar date
 func Julday(year, month, day int32, hour float64, gregflag int32) float64 {
 	return float64(C.swe_julday(
@@ -235,7 +258,7 @@ func DateConversion(year, month, day int32, hour float64, cal byte) (float64, er
 	)
 
 	if result == ERR {
-		return 0, fmt.Errorf("invalid date")
+		return 0, &Error{Func: "swe_date_conversion", Flag: ERR, Message: "invalid date", kinds: []error{ErrInvalidDate}}
 	}
 
 	return float64(tjd), nil
@@ -259,7 +282,7 @@ func UtcToJd(year, month, day, hour, min int32, sec float64, gregflag int32) (dr
 	)
 
 	if result == ERR {
-		return dret, fmt.Errorf("%s", C.GoString(&serr[0]))
+		return dret, newError("swe_utc_to_jd", ERR, C.GoString(&serr[0]), true)
 	}
 
 	dret[0] = float64(dretC[0])
@@ -390,6 +413,12 @@ func Houses(tjdUt float64, geolat, geolon float64, hsys byte) HousesResult {
 	return result
 }
 
+// HousesE is Houses returning an error if the calculation failed
+func HousesE(tjdUt float64, geolat, geolon float64, hsys byte) (HousesResult, error) {
+	result := Houses(tjdUt, geolat, geolon, hsys)
+	return result, newError("swe_houses", result.Flag, result.Error, result.Flag < 0)
+}
+
 // HousesEx calculates house cusps with extended options
 func HousesEx(tjdUt float64, iflag int32, geolat, geolon float64, hsys byte) HousesResult {
 	var cusps [37]C.double
@@ -427,6 +456,12 @@ func HousesEx(tjdUt float64, iflag int32, geolat, geolon float64, hsys byte) Hou
 	return result
 }
 
+// HousesExE is HousesEx returning an error if the calculation failed
+func HousesExE(tjdUt float64, iflag int32, geolat, geolon float64, hsys byte) (HousesResult, error) {
+	result := HousesEx(tjdUt, iflag, geolat, geolon, hsys)
+	return result, newError("swe_houses_ex", result.Flag, result.Error, result.Flag < 0)
+}
+
 // HousesEx2 calculates house cusps with extended options (version 2)
 func HousesEx2(tjdUt float64, iflag int32, geolat, geolon float64, hsys byte) HousesResult {
 	var cusps [37]C.double
@@ -454,22 +489,33 @@ func HousesEx2(tjdUt float64, iflag int32, geolat, geolon float64, hsys byte) Ho
 	}
 
 	result := HousesResult{
-		Flag:   int32(flag),
-		Houses: make([]float64, numHouses),
-		Points: make([]float64, 8),
+		Flag:        int32(flag),
+		Houses:      make([]float64, numHouses),
+		Points:      make([]float64, 8),
+		HouseSpeeds: make([]float64, numHouses),
+		PointSpeeds: make([]float64, 8),
+		Error:       C.GoString(&serr[0]),
 	}
 
 	for i := 0; i < numHouses; i++ {
 		result.Houses[i] = float64(cusps[i+1])
+		result.HouseSpeeds[i] = float64(cuspSpeed[i+1])
 	}
 
 	for i := 0; i < 8; i++ {
 		result.Points[i] = float64(ascmc[i])
+		result.PointSpeeds[i] = float64(ascmcSpeed[i])
 	}
 
 	return result
 }
 
+// HousesEx2E is HousesEx2 returning an error if the calculation failed
+func HousesEx2E(tjdUt float64, iflag int32, geolat, geolon float64, hsys byte) (HousesResult, error) {
+	result := HousesEx2(tjdUt, iflag, geolat, geolon, hsys)
+	return result, newError("swe_houses_ex2", result.Flag, result.Error, result.Flag < 0)
+}
+
 // HousesArmc calculates house cusps from ARMC
 func HousesArmc(armc float64, geolat float64, eps float64, hsys byte) HousesResult {
 	var cusps [37]C.double
@@ -506,6 +552,12 @@ func HousesArmc(armc float64, geolat float64, eps float64, hsys byte) HousesResu
 	return result
 }
 
+// HousesArmcE is HousesArmc returning an error if the calculation failed
+func HousesArmcE(armc float64, geolat float64, eps float64, hsys byte) (HousesResult, error) {
+	result := HousesArmc(armc, geolat, eps, hsys)
+	return result, newError("swe_houses_armc", result.Flag, result.Error, result.Flag < 0)
+}
+
 // HousesArmcEx2 calculates house cusps from ARMC with extended options
 func HousesArmcEx2(armc float64, geolat float64, eps float64, hsys byte) HousesResult {
 	var cusps [37]C.double
@@ -532,22 +584,33 @@ func HousesArmcEx2(armc float64, geolat float64, eps float64, hsys byte) HousesR
 	}
 
 	result := HousesResult{
-		Flag:   int32(flag),
-		Houses: make([]float64, numHouses),
-		Points: make([]float64, 8),
+		Flag:        int32(flag),
+		Houses:      make([]float64, numHouses),
+		Points:      make([]float64, 8),
+		HouseSpeeds: make([]float64, numHouses),
+		PointSpeeds: make([]float64, 8),
+		Error:       C.GoString(&serr[0]),
 	}
 
 	for i := 0; i < numHouses; i++ {
 		result.Houses[i] = float64(cusps[i+1])
+		result.HouseSpeeds[i] = float64(cuspSpeed[i+1])
 	}
 
 	for i := 0; i < 8; i++ {
 		result.Points[i] = float64(ascmc[i])
+		result.PointSpeeds[i] = float64(ascmcSpeed[i])
 	}
 
 	return result
 }
 
+// HousesArmcEx2E is HousesArmcEx2 returning an error if the calculation failed
+func HousesArmcEx2E(armc float64, geolat float64, eps float64, hsys byte) (HousesResult, error) {
+	result := HousesArmcEx2(armc, geolat, eps, hsys)
+	return result, newError("swe_houses_armc_ex2", result.Flag, result.Error, result.Flag < 0)
+}
+
 // HousePos calculates the house position of a celestial point
 func HousePos(armc float64, geolat float64, eps float64, hsys byte, lon float64, lat float64) (float64, error) {
 	var xpin [2]C.double
@@ -566,7 +629,7 @@ func HousePos(armc float64, geolat float64, eps float64, hsys byte, lon float64,
 	)
 
 	if pos < 0 {
-		return 0, fmt.Errorf("%s", C.GoString(&serr[0]))
+		return 0, newError("swe_house_pos", ERR, C.GoString(&serr[0]), true)
 	}
 
 	return float64(pos), nil
@@ -620,6 +683,12 @@ func GetAyanamsaEx(tjdEt float64, iflag int32) CalcResult {
 	}
 }
 
+// GetAyanamsaExE is GetAyanamsaEx returning an error for failures, warnings and fallbacks to Moshier
+func GetAyanamsaExE(tjdEt float64, iflag int32) (CalcResult, error) {
+	result := GetAyanamsaEx(tjdEt, iflag)
+	return result, calcError("swe_get_ayanamsa_ex", iflag, result.Flag, result.Error)
+}
+
 // GetAyanamsaExUT calculates the ayanamsa with extended information (universal time)
 func GetAyanamsaExUT(tjdUt float64, iflag int32) CalcResult {
 	var daya C.double
@@ -639,6 +708,12 @@ func GetAyanamsaExUT(tjdUt float64, iflag int32) CalcResult {
 	}
 }
 
+// GetAyanamsaExUTE is GetAyanamsaExUT returning an error for failures, warnings and fallbacks to Moshier
+func GetAyanamsaExUTE(tjdUt float64, iflag int32) (CalcResult, error) {
+	result := GetAyanamsaExUT(tjdUt, iflag)
+	return result, calcError("swe_get_ayanamsa_ex_ut", iflag, result.Flag, result.Error)
+}
+
 // Sidtime calculates sidereal time
 func Sidtime(tjdUt float64) float64 {
 	return float64(C.swe_sidtime(C.double(tjdUt)))
@@ -664,9 +739,8 @@ func DeltatEx(tjd float64, iflag int32) (float64, error) {
 		&serr[0],
 	)
 
-	errStr := C.GoString(&serr[0])
-	if errStr != "" {
-		return float64(dt), fmt.Errorf("%s", errStr)
+	if err := newError("swe_deltat_ex", OK, C.GoString(&serr[0]), false); err != nil {
+		return float64(dt), err
 	}
 
 	return float64(dt), nil
@@ -684,7 +758,7 @@ func TimeEqu(tjd float64) (float64, error) {
 	)
 
 	if result == ERR {
-		return 0, fmt.Errorf("%s", C.GoString(&serr[0]))
+		return 0, newError("swe_time_equ", ERR, C.GoString(&serr[0]), true)
 	}
 
 	return float64(e), nil
@@ -703,7 +777,7 @@ func LmtToLat(tjdLmt float64, geolon float64) (float64, error) {
 	)
 
 	if result == ERR {
-		return 0, fmt.Errorf("%s", C.GoString(&serr[0]))
+		return 0, newError("swe_lmt_to_lat", ERR, C.GoString(&serr[0]), true)
 	}
 
 	return float64(tjdLat), nil
@@ -722,7 +796,7 @@ func LatToLmt(tjdLat float64, geolon float64) (float64, error) {
 	)
 
 	if result == ERR {
-		return 0, fmt.Errorf("%s", C.GoString(&serr[0]))
+		return 0, newError("swe_lat_to_lmt", ERR, C.GoString(&serr[0]), true)
 	}
 
 	return float64(tjdLmt), nil
@@ -0,0 +1,89 @@
+// Go Swiss Ephemeris - Thread Settings
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+/*
+#include "swephexp.h"
+#include "sweph.h"
+#include "swephlib.h"
+
+static void get_thread_settings(struct sid_data *sidd, AS_BOOL *ayana_set, double *topo, AS_BOOL *topo_set) {
+	*sidd = swed.sidd;
+	*ayana_set = swed.ayana_is_set;
+	topo[0] = swed.topd.geolon;
+	topo[1] = swed.topd.geolat;
+	topo[2] = swed.topd.geoalt;
+	*topo_set = swed.geopos_is_set;
+}
+
+static void set_thread_settings(struct sid_data sidd, AS_BOOL ayana_set, double *topo, AS_BOOL topo_set) {
+	swe_set_sid_mode(sidd.sid_mode, sidd.t0, sidd.ayan_t0);
+	swed.sidd = sidd;
+	swed.ayana_is_set = ayana_set;
+	if (topo_set) {
+		swe_set_topo(topo[0], topo[1], topo[2]);
+	} else {
+		if (swed.geopos_is_set) {
+			swed.topd.teval = 0;
+			swi_force_app_pos_etc();
+		}
+		swed.topd.geolon = topo[0];
+		swed.topd.geolat = topo[1];
+		swed.topd.geoalt = topo[2];
+		swed.geopos_is_set = FALSE;
+	}
+}
+*/
+import "C"
+
+import "runtime"
+
+// threadSettings are the thread-local sidereal mode and topocentric
+// location of the C library
+type threadSettings struct {
+	sidd     C.struct_sid_data
+	ayanaSet C.AS_BOOL
+	topo     [3]C.double
+	topoSet  C.AS_BOOL
+}
+
+// getThreadSettings returns the settings of the calling thread
+func getThreadSettings() threadSettings {
+	var s threadSettings
+	C.get_thread_settings(&s.sidd, &s.ayanaSet, &s.topo[0], &s.topoSet)
+	return s
+}
+
+// set applies the settings to the calling thread
+func (s threadSettings) set() {
+	C.set_thread_settings(s.sidd, s.ayanaSet, &s.topo[0], s.topoSet)
+}
+
+// lockThreadSettings locks the calling goroutine to its OS thread so that
+// its sidereal mode and topocentric location may be changed. The returned
+// function restores the previous settings and unlocks the thread.
+func lockThreadSettings() func() {
+	runtime.LockOSThread()
+	saved := getThreadSettings()
+	return func() {
+		if getThreadSettings() != saved {
+			saved.set()
+		}
+		runtime.UnlockOSThread()
+	}
+}
@@ -0,0 +1,209 @@
+// Go Swiss Ephemeris - time.Time Integration
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"time"
+)
+
+// EclipseTimes represents eclipse search results as time.Time values
+type EclipseTimes struct {
+	Flag    int32     // Eclipse type flags
+	Maximum time.Time // Time of maximum eclipse
+	Begin   time.Time // Begin time (zero if not applicable)
+	End     time.Time // End time (zero if not applicable)
+	Attr    []float64 // Eclipse attributes
+}
+
+// JDFromTime converts a time.Time to Julian days in UT1 and TT.
+// The time is converted to UTC using its location first; leap seconds are
+// taken into account by UtcToJd.
+func JDFromTime(t time.Time) (ut, tt float64, err error) {
+	u := t.UTC()
+	sec := float64(u.Second()) + float64(u.Nanosecond())/1e9
+
+	dret, err := UtcToJd(int32(u.Year()), int32(u.Month()), int32(u.Day()),
+		int32(u.Hour()), int32(u.Minute()), sec, GregCal)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return dret[1], dret[0], nil
+}
+
+// TimeFromJD converts a Julian day in UT1 to a time.Time in UTC.
+// A Julian day of 0, used by the C library for events that do not apply,
+// returns the zero time.
+func TimeFromJD(tjdUt float64) time.Time {
+	if tjdUt == 0 {
+		return time.Time{}
+	}
+	return timeFromUTC(Jdut1ToUtc(tjdUt, GregCal))
+}
+
+// TimeFromJDET converts a Julian day in TT (ephemeris time) to a time.Time in UTC
+func TimeFromJDET(tjdEt float64) time.Time {
+	if tjdEt == 0 {
+		return time.Time{}
+	}
+	return timeFromUTC(JdetToUtc(tjdEt, GregCal))
+}
+
+// timeFromUTC converts a UTCResult to a time.Time, rounded to the microsecond
+// which is about the precision of a Julian day in a float64. A leap second
+// (second 60) is normalized into the following minute.
+func timeFromUTC(utc UTCResult) time.Time {
+	sec, frac := math.Modf(utc.Second)
+	nsec := int(math.Round(frac*1e6)) * 1000
+	return time.Date(utc.Year, time.Month(utc.Month), utc.Day, utc.Hour, utc.Minute, int(sec), nsec, time.UTC)
+}
+
+// timeToJD converts a time.Time to a Julian day in UT1
+func timeToJD(t time.Time) (float64, error) {
+	ut, _, err := JDFromTime(t)
+	return ut, err
+}
+
+// CalcUTAt is CalcUTE for a time.Time
+func CalcUTAt(t time.Time, ipl int32, iflag int32) (CalcResult, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return CalcResult{Flag: ERR, Error: err.Error()}, err
+	}
+	return CalcUTE(jd, ipl, iflag)
+}
+
+// CalcUTPositionAt is CalcUTPosition for a time.Time
+func CalcUTPositionAt(t time.Time, ipl int32, iflag int32) (Position, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return Position{Flag: ERR}, err
+	}
+	return CalcUTPosition(jd, ipl, iflag)
+}
+
+// HousesAt is HousesE for a time.Time
+func HousesAt(t time.Time, geolat, geolon float64, hsys byte) (HousesResult, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return HousesResult{Flag: ERR, Error: err.Error()}, err
+	}
+	return HousesE(jd, geolat, geolon, hsys)
+}
+
+// HousesExAt is HousesExE for a time.Time
+func HousesExAt(t time.Time, iflag int32, geolat, geolon float64, hsys byte) (HousesResult, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return HousesResult{Flag: ERR, Error: err.Error()}, err
+	}
+	return HousesExE(jd, iflag, geolat, geolon, hsys)
+}
+
+// RiseTransAt finds the next rise, set or transit after t.
+// The error matches ErrCircumpolar if the body does not rise or set.
+func RiseTransAt(t time.Time, ipl int32, starname string, epheflag int32, rsmi int32, geopos [3]float64, atpress float64, attemp float64) (time.Time, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	result, err := RiseTransE(jd, ipl, starname, epheflag, rsmi, geopos, atpress, attemp)
+	if err != nil && !IsWarning(err) {
+		return time.Time{}, err
+	}
+	return TimeFromJD(result.Time), err
+}
+
+// SolcrossUTAt finds the next time after t when the Sun crosses a longitude
+func SolcrossUTAt(x2cross float64, t time.Time, flag int32) (time.Time, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	jdcross, err := SolcrossUT(x2cross, jd, flag)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return TimeFromJD(jdcross), nil
+}
+
+// MooncrossUTAt finds the next time after t when the Moon crosses a longitude
+func MooncrossUTAt(x2cross float64, t time.Time, flag int32) (time.Time, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	jdcross, err := MooncrossUT(x2cross, jd, flag)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return TimeFromJD(jdcross), nil
+}
+
+// SolEclipseWhenLocAt finds the next solar eclipse after t for a given location
+func SolEclipseWhenLocAt(t time.Time, ifl int32, geopos [3]float64, backward bool) (EclipseTimes, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return EclipseTimes{Flag: ERR}, err
+	}
+	return eclipseTimes(SolEclipseWhenLocE(jd, ifl, geopos, backward))
+}
+
+// SolEclipseWhenGlobAt finds the next solar eclipse after t globally
+func SolEclipseWhenGlobAt(t time.Time, ifl int32, ifltype int32, backward bool) (EclipseTimes, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return EclipseTimes{Flag: ERR}, err
+	}
+	return eclipseTimes(SolEclipseWhenGlobE(jd, ifl, ifltype, backward))
+}
+
+// LunEclipseWhenAt finds the next lunar eclipse after t
+func LunEclipseWhenAt(t time.Time, ifl int32, ifltype int32, backward bool) (EclipseTimes, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return EclipseTimes{Flag: ERR}, err
+	}
+	return eclipseTimes(LunEclipseWhenE(jd, ifl, ifltype, backward))
+}
+
+// LunEclipseWhenLocAt finds the next lunar eclipse after t for a given location
+func LunEclipseWhenLocAt(t time.Time, ifl int32, geopos [3]float64, backward bool) (EclipseTimes, error) {
+	jd, err := timeToJD(t)
+	if err != nil {
+		return EclipseTimes{Flag: ERR}, err
+	}
+	return eclipseTimes(LunEclipseWhenLocE(jd, ifl, geopos, backward))
+}
+
+// eclipseTimes converts the Julian days of an eclipse result to time.Time
+func eclipseTimes(result EclipseResult, err error) (EclipseTimes, error) {
+	times := EclipseTimes{Flag: result.Flag, Attr: result.Attr}
+	if err != nil && !IsWarning(err) {
+		return times, err
+	}
+
+	times.Maximum = TimeFromJD(result.Maximum)
+	times.Begin = TimeFromJD(result.Begin)
+	times.End = TimeFromJD(result.End)
+	return times, err
+}
@@ -0,0 +1,85 @@
+// Go Swiss Ephemeris - time.Time Integration Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJDFromTime(t *testing.T) {
+	ut, tt, err := JDFromTime(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("JDFromTime failed: %v", err)
+	}
+
+	// UT1 differs from UTC by less than a second
+	if math.Abs(ut-2451545.0)*86400 > 1 {
+		t.Errorf("JD UT unexpected: %.8f", ut)
+	}
+	// TT - UT is about 64 seconds in 2000
+	if dt := (tt - ut) * 86400; dt < 60 || dt > 70 {
+		t.Errorf("TT - UT unexpected: %.3f seconds", dt)
+	}
+
+	// The same instant in another zone gives the same Julian day
+	zone := time.FixedZone("UTC-5", -5*3600)
+	utZone, _, err := JDFromTime(time.Date(2000, 1, 1, 7, 0, 0, 0, zone))
+	if err != nil {
+		t.Fatalf("JDFromTime failed: %v", err)
+	}
+	if utZone != ut {
+		t.Errorf("JD in UTC-5 = %.8f, expected %.8f", utZone, ut)
+	}
+}
+
+func TestTimeFromJDRoundTrip(t *testing.T) {
+	want := time.Date(2024, 3, 20, 3, 6, 21, 500000000, time.UTC)
+
+	ut, tt, err := JDFromTime(want)
+	if err != nil {
+		t.Fatalf("JDFromTime failed: %v", err)
+	}
+
+	for name, got := range map[string]time.Time{
+		"TimeFromJD":   TimeFromJD(ut),
+		"TimeFromJDET": TimeFromJDET(tt),
+	} {
+		if d := got.Sub(want); d < -time.Millisecond || d > time.Millisecond {
+			t.Errorf("%s = %s, expected %s", name, got, want)
+		}
+	}
+
+	if !TimeFromJD(0).IsZero() {
+		t.Error("TimeFromJD(0) should return the zero time")
+	}
+}
+
+func TestSolcrossUTAt(t *testing.T) {
+	// March equinox 2024 was at 03:06 UTC on March 20
+	equinox, err := SolcrossUTAt(0, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), FlagMoseph)
+	if err != nil {
+		t.Fatalf("SolcrossUTAt failed: %v", err)
+	}
+
+	want := time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC)
+	if d := equinox.Sub(want); d < -2*time.Minute || d > 2*time.Minute {
+		t.Errorf("Equinox at %s, expected about %s", equinox, want)
+	}
+}
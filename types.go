@@ -19,16 +19,28 @@ package swisseph
 
 // CalcResult represents the result of a planetary calculation
 type CalcResult struct {
-	Flag  int32     // Return flag
-	Error string    // Error message if any
-	Data  []float64 // Calculation results (longitude, latitude, distance, speed, etc.)
+	Flag     int32              // Return flag
+	Error    string             // Error message if any
+	Data     []float64          // Calculation results (longitude, latitude, distance, speed, etc.)
+	Fallback *EphemerisFallback // Set if another ephemeris than requested was used
+}
+
+// EphemerisFallback describes a calculation that was done with another
+// ephemeris than the requested one, e.g. Moshier when .se1 files are missing
+type EphemerisFallback struct {
+	Requested int32  // Requested ephemeris (FlagJpleph, FlagSwieph or FlagMoseph)
+	Used      int32  // Ephemeris actually used
+	Reason    string // Message from the C library explaining the fallback, if any
 }
 
 // HousesResult represents the result of house calculations
 type HousesResult struct {
-	Flag   int32     // Return flag
-	Houses []float64 // House cusps
-	Points []float64 // Ascendant, MC, ARMC, Vertex, etc.
+	Flag        int32     // Return flag
+	Houses      []float64 // House cusps
+	Points      []float64 // Ascendant, MC, ARMC, Vertex, etc.
+	HouseSpeeds []float64 // Speeds of the house cusps in degrees per day (Ex2 functions only)
+	PointSpeeds []float64 // Speeds of the points in degrees per day (Ex2 functions only)
+	Error       string    // Error message if any
 }
 
 // JulianDay represents a Julian day number
@@ -81,10 +93,11 @@ type RiseTransResult struct {
 
 // FixstarResult represents fixed star calculation results
 type FixstarResult struct {
-	Flag     int32     // Return flag
-	StarName string    // Actual star name used
-	Data     []float64 // Position data
-	Error    string    // Error message if any
+	Flag     int32              // Return flag
+	StarName string             // Actual star name used
+	Data     []float64          // Position data
+	Error    string             // Error message if any
+	Fallback *EphemerisFallback // Set if another ephemeris than requested was used
 }
 
 // FixstarMagResult represents fixed star magnitude
@@ -97,12 +110,13 @@ type FixstarMagResult struct {
 
 // NodApsResult represents nodes and apsides calculation results
 type NodApsResult struct {
-	Flag        int32     // Return flag
-	Ascending   []float64 // Ascending node data
-	Descending  []float64 // Descending node data
-	Perihelion  []float64 // Perihelion data
-	Aphelion    []float64 // Aphelion data
-	Error       string    // Error message if any
+	Flag       int32              // Return flag
+	Ascending  []float64          // Ascending node data
+	Descending []float64          // Descending node data
+	Perihelion []float64          // Perihelion data
+	Aphelion   []float64          // Aphelion data
+	Error      string             // Error message if any
+	Fallback   *EphemerisFallback // Set if another ephemeris than requested was used
 }
 
 // OrbitalElementsResult represents orbital elements
@@ -23,7 +23,6 @@ package swisseph
 // #include "swephexp.h"
 import "C"
 import (
-	"fmt"
 	"unsafe"
 )
 
@@ -60,6 +59,13 @@ func RiseTrans(tjdUt float64, ipl int32, starname string, epheflag int32, rsmi i
 	}
 }
 
+// RiseTransE is RiseTrans returning an error if the calculation failed.
+// The error matches ErrCircumpolar if the body does not rise or set.
+func RiseTransE(tjdUt float64, ipl int32, starname string, epheflag int32, rsmi int32, geopos [3]float64, atpress float64, attemp float64) (RiseTransResult, error) {
+	result := RiseTrans(tjdUt, ipl, starname, epheflag, rsmi, geopos, atpress, attemp)
+	return result, riseTransError("swe_rise_trans", result)
+}
+
 // RiseTransTrueHor calculates rise, set, and transit times with true horizon
 func RiseTransTrueHor(tjdUt float64, ipl int32, starname string, epheflag int32, rsmi int32, geopos [3]float64, atpress float64, attemp float64, horhgt float64) RiseTransResult {
 	var tret C.double
@@ -94,6 +100,27 @@ func RiseTransTrueHor(tjdUt float64, ipl int32, starname string, epheflag int32,
 	}
 }
 
+// RiseTransTrueHorE is RiseTransTrueHor returning an error if the calculation failed.
+// The error matches ErrCircumpolar if the body does not rise or set.
+func RiseTransTrueHorE(tjdUt float64, ipl int32, starname string, epheflag int32, rsmi int32, geopos [3]float64, atpress float64, attemp float64, horhgt float64) (RiseTransResult, error) {
+	result := RiseTransTrueHor(tjdUt, ipl, starname, epheflag, rsmi, geopos, atpress, attemp, horhgt)
+	return result, riseTransError("swe_rise_trans_true_hor", result)
+}
+
+// riseTransError converts a rise/set result to an error; a return flag of -2
+// means the body is circumpolar
+func riseTransError(fn string, result RiseTransResult) error {
+	if result.Flag != -2 {
+		return newError(fn, result.Flag, result.Error, result.Flag < 0)
+	}
+
+	seErr := newError(fn, result.Flag, result.Error, true).(*Error)
+	if !containsError(seErr.kinds, ErrCircumpolar) {
+		seErr.kinds = append(seErr.kinds, ErrCircumpolar)
+	}
+	return seErr
+}
+
 // Pheno calculates planetary phenomena (ephemeris time)
 func Pheno(tjdEt float64, ipl int32, iflag int32) CalcResult {
 	var attr [20]C.double
@@ -120,6 +147,12 @@ func Pheno(tjdEt float64, ipl int32, iflag int32) CalcResult {
 	return result
 }
 
+// PhenoE is Pheno returning an error for failures, warnings and fallbacks to Moshier
+func PhenoE(tjdEt float64, ipl int32, iflag int32) (CalcResult, error) {
+	result := Pheno(tjdEt, ipl, iflag)
+	return result, calcError("swe_pheno", iflag, result.Flag, result.Error)
+}
+
 // PhenoUT calculates planetary phenomena (universal time)
 func PhenoUT(tjdUt float64, ipl int32, iflag int32) CalcResult {
 	var attr [20]C.double
@@ -146,6 +179,12 @@ func PhenoUT(tjdUt float64, ipl int32, iflag int32) CalcResult {
 	return result
 }
 
+// PhenoUTE is PhenoUT returning an error for failures, warnings and fallbacks to Moshier
+func PhenoUTE(tjdUt float64, ipl int32, iflag int32) (CalcResult, error) {
+	result := PhenoUT(tjdUt, ipl, iflag)
+	return result, calcError("swe_pheno_ut", iflag, result.Flag, result.Error)
+}
+
 // Azalt calculates azimuth and altitude from ecliptic or equatorial coordinates
 func Azalt(tjdUt float64, calcflag int32, geopos [3]float64, atpress float64, attemp float64, xin [3]float64) AzaltResult {
 	var xinC [3]C.double
@@ -287,6 +326,18 @@ func Radnorm(x float64) float64 {
 	return float64(C.swe_radnorm(C.double(x)))
 }
 
+// DegMidp returns the midpoint of two longitudes in degrees on the shorter
+// arc between them
+func DegMidp(x1 float64, x0 float64) float64 {
+	return float64(C.swe_deg_midp(C.double(x1), C.double(x0)))
+}
+
+// RadMidp returns the midpoint of two angles in radians on the shorter arc
+// between them
+func RadMidp(x1 float64, x0 float64) float64 {
+	return float64(C.swe_rad_midp(C.double(x1), C.double(x0)))
+}
+
 // Csnorm normalizes centiseconds to 0-360° range
 func Csnorm(p int32) int32 {
 	return int32(C.swe_csnorm(C.int(p)))
@@ -412,9 +463,17 @@ func NodAps(tjdEt float64, ipl int32, iflag int32, method int32) NodApsResult {
 		result.Aphelion[i] = float64(xaphe[i])
 	}
 
+	result.Fallback = ephemerisFallback(iflag, result.Flag, result.Error)
+
 	return result
 }
 
+// NodApsE is NodAps returning an error for failures, warnings and fallbacks to Moshier
+func NodApsE(tjdEt float64, ipl int32, iflag int32, method int32) (NodApsResult, error) {
+	result := NodAps(tjdEt, ipl, iflag, method)
+	return result, calcError("swe_nod_aps", iflag, result.Flag, result.Error)
+}
+
 // NodApsUT calculates nodes and apsides (universal time)
 func NodApsUT(tjdUt float64, ipl int32, iflag int32, method int32) NodApsResult {
 	var xnasc [6]C.double
@@ -451,9 +510,17 @@ func NodApsUT(tjdUt float64, ipl int32, iflag int32, method int32) NodApsResult
 		result.Aphelion[i] = float64(xaphe[i])
 	}
 
+	result.Fallback = ephemerisFallback(iflag, result.Flag, result.Error)
+
 	return result
 }
 
+// NodApsUTE is NodApsUT returning an error for failures, warnings and fallbacks to Moshier
+func NodApsUTE(tjdUt float64, ipl int32, iflag int32, method int32) (NodApsResult, error) {
+	result := NodApsUT(tjdUt, ipl, iflag, method)
+	return result, calcError("swe_nod_aps_ut", iflag, result.Flag, result.Error)
+}
+
 // GetOrbitalElements calculates orbital elements
 func GetOrbitalElements(tjdEt float64, ipl int32, iflag int32) OrbitalElementsResult {
 	var dret [50]C.double
@@ -480,6 +547,12 @@ func GetOrbitalElements(tjdEt float64, ipl int32, iflag int32) OrbitalElementsRe
 	return result
 }
 
+// GetOrbitalElementsE is GetOrbitalElements returning an error if the calculation failed
+func GetOrbitalElementsE(tjdEt float64, ipl int32, iflag int32) (OrbitalElementsResult, error) {
+	result := GetOrbitalElements(tjdEt, ipl, iflag)
+	return result, newError("swe_get_orbital_elements", result.Flag, result.Error, result.Flag < 0)
+}
+
 // OrbitMaxMinTrueDistance calculates maximum and minimum true distance
 func OrbitMaxMinTrueDistance(tjdEt float64, ipl int32, iflag int32) (dmax float64, dmin float64, dtrue float64, err error) {
 	var dmaxC, dminC, dtrueC C.double
@@ -496,7 +569,7 @@ func OrbitMaxMinTrueDistance(tjdEt float64, ipl int32, iflag int32) (dmax float6
 	)
 
 	if result == ERR {
-		return 0, 0, 0, fmt.Errorf("%s", C.GoString(&serr[0]))
+		return 0, 0, 0, newError("swe_orbit_max_min_true_distance", ERR, C.GoString(&serr[0]), true)
 	}
 
 	return float64(dmaxC), float64(dminC), float64(dtrueC), nil
@@ -549,7 +622,7 @@ func GauquelinSector(tjdUt float64, ipl int32, starname string, iflag int32, ime
 	)
 
 	if result == ERR {
-		return 0, fmt.Errorf("%s", C.GoString(&serr[0]))
+		return 0, newError("swe_gauquelin_sector", ERR, C.GoString(&serr[0]), true)
 	}
 
 	return float64(dgsect), nil
@@ -0,0 +1,168 @@
+// Go Swiss Ephemeris - Divisional Charts
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import "math"
+
+// VargaScheme selects how the divisions of a sign map to signs
+type VargaScheme int
+
+const (
+	// VargaParashari follows the rules of Brihat Parashara Hora Shastra for
+	// D2, D3, D4, D7, D9, D10, D12, D16, D20, D24, D27, D30, D40, D45 and
+	// D60, and VargaParivritti for the other divisions
+	VargaParashari VargaScheme = iota
+
+	// VargaParivritti counts the divisions continuously through the zodiac
+	// from Aries, so that the n-th division of a sign follows the last of
+	// the sign before it
+	VargaParivritti
+)
+
+// trimsamsaParts are the Parashari D30 divisions of odd and even signs,
+// given by their end in degrees and the sign they map to
+var trimsamsaParts = [2][5]struct {
+	end  float64
+	sign int
+}{
+	{{5, 0}, {10, 10}, {18, 8}, {25, 2}, {30, 6}}, // Mars, Saturn, Jupiter, Mercury, Venus
+	{{5, 1}, {12, 5}, {20, 11}, {25, 9}, {30, 7}}, // Venus, Mercury, Jupiter, Saturn, Mars
+}
+
+// Varga returns the longitude of a sidereal longitude in a divisional chart
+// (D1 to D60): the sign of the division and the position within the
+// division stretched to the whole sign.
+func Varga(lon float64, division int, scheme VargaScheme) float64 {
+	lon = normDeg(lon)
+	if division <= 1 {
+		return lon
+	}
+
+	sign := int(lon / 30)
+	deg := lon - float64(sign)*30
+	n := float64(division)
+	part := int(deg * n / 30)
+	if part >= division {
+		part = division - 1
+	}
+	within := math.Mod(deg*n, 30)
+
+	if division == 30 && scheme == VargaParashari {
+		return trimsamsa(sign, deg)
+	}
+
+	odd := sign%2 == 0 // Aries is the first, odd sign
+	start := sign * division
+	if scheme == VargaParashari {
+		switch division {
+		case 2:
+			start = 4 - 2*part // Leo then Cancer in odd signs
+			if !odd {
+				start = 3 // Cancer then Leo in even signs
+			}
+		case 3:
+			start = sign + 3*part
+		case 4:
+			start = sign + 2*part
+		case 7, 10:
+			start = sign
+			if !odd {
+				start = sign + 6
+				if division == 10 {
+					start = sign + 8
+				}
+			}
+		case 12, 60:
+			start = sign
+		case 16, 45:
+			start = [3]int{0, 4, 8}[sign%3]
+		case 20:
+			start = [3]int{0, 8, 4}[sign%3]
+		case 24:
+			start = 4
+			if !odd {
+				start = 3
+			}
+		case 40:
+			start = 0
+			if !odd {
+				start = 6
+			}
+		}
+	}
+
+	return float64((start+part)%12)*30 + within
+}
+
+// trimsamsa returns the Parashari D30 longitude of a position in a sign
+func trimsamsa(sign int, deg float64) float64 {
+	begin := 0.0
+	for _, p := range trimsamsaParts[sign%2] {
+		if deg < p.end || p.end == 30 {
+			return float64(p.sign)*30 + (deg-begin)/(p.end-begin)*30
+		}
+		begin = p.end
+	}
+	return 0
+}
+
+// VargaChart returns the divisional chart of a chart. The bodies and angles
+// move to their Varga longitudes and the houses are whole signs from the
+// divisional Ascendant. Build the chart with WithSidereal for the usual
+// sidereal vargas; its Ascendant is then sidereal as from HousesEx with
+// FlagSidereal.
+func VargaChart(chart *Chart, division int, scheme VargaScheme) *Chart {
+	v := *chart
+	v.Division = division
+
+	v.Bodies = make([]ChartBody, len(chart.Bodies))
+	for i, b := range chart.Bodies {
+		b.Position.Lon = Varga(b.Position.Lon, division, scheme)
+		b.Position.LonSpeed *= float64(division)
+		b.Sign = int(b.Position.Lon / 30)
+		b.SignDegree = b.Position.Lon - float64(b.Sign)*30
+		v.Bodies[i] = b
+	}
+
+	v.Angles = make([]float64, len(chart.Angles))
+	for i, a := range chart.Angles {
+		v.Angles[i] = Varga(a, division, scheme)
+	}
+	v.Angles[ARMC] = chart.Angles[ARMC]
+	v.AngleSpeeds = nil
+	v.CuspSpeeds = nil
+
+	lagna := int(v.Angles[Asc] / 30)
+	v.Cusps = make([]float64, 12)
+	for i := range v.Cusps {
+		v.Cusps[i] = float64((lagna+i)%12) * 30
+	}
+	v.HouseSystem = 'W'
+	for i := range v.Bodies {
+		v.Bodies[i].HousePos = cuspHousePos(v.Bodies[i].Position.Lon, v.Cusps)
+		v.Bodies[i].House = int(v.Bodies[i].HousePos)
+	}
+
+	points := make([]ChartPoint, len(v.Bodies))
+	for i, b := range v.Bodies {
+		points[i] = b.Point()
+	}
+	v.Aspects = CalcAspects(points, newChartConfig(chart.opts).aspects)
+
+	return &v
+}
@@ -0,0 +1,93 @@
+// Go Swiss Ephemeris - Divisional Chart Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVarga(t *testing.T) {
+	tests := []struct {
+		lon      float64
+		division int
+		scheme   VargaScheme
+		expected float64
+	}{
+		{12, 1, VargaParashari, 12},
+		{1 + 2.0/3, 9, VargaParashari, 15},   // Aries navamsa
+		{30, 9, VargaParashari, 270},         // Taurus starts in Capricorn
+		{90, 9, VargaParashari, 90},          // Cancer starts in Cancer
+		{10, 2, VargaParashari, 4*30 + 20},   // Leo hora of an odd sign
+		{20, 2, VargaParashari, 3*30 + 10},   // Cancer hora of an odd sign
+		{40, 2, VargaParashari, 3*30 + 20},   // Cancer hora of an even sign
+		{50, 2, VargaParashari, 4*30 + 10},   // Leo hora of an even sign
+		{15, 3, VargaParashari, 4*30 + 15},   // Second drekkana in the 5th sign
+		{25, 3, VargaParashari, 8*30 + 15},   // Third drekkana in the 9th sign
+		{15, 3, VargaParivritti, 1*30 + 15},  // Parivritti drekkana
+		{50, 3, VargaParivritti, 5*30 + 0},   // Taurus continues from Aries
+		{20, 4, VargaParashari, 6*30 + 20},   // Third chaturthamsa in the 7th sign
+		{35, 7, VargaParashari, 8*30 + 5},    // Even sign counts from the 7th
+		{35, 10, VargaParashari, 10*30 + 20}, // Even sign counts from the 9th
+		{2.5, 12, VargaParashari, 30},
+		{30, 16, VargaParashari, 4 * 30}, // Fixed sign starts in Leo
+		{30, 20, VargaParashari, 8 * 30}, // Fixed sign starts in Sagittarius
+		{0, 24, VargaParashari, 4 * 30},  // Odd sign starts in Leo
+		{30, 24, VargaParashari, 3 * 30}, // Even sign starts in Cancer
+		{30, 27, VargaParashari, 3 * 30}, // Earth sign starts in Cancer
+		{30, 40, VargaParashari, 6 * 30}, // Even sign starts in Libra
+		{60, 45, VargaParashari, 8 * 30}, // Dual sign starts in Sagittarius
+		{0.25, 60, VargaParashari, 15},   // First shashtiamsa
+		{29.9, 60, VargaParashari, 11*30 + 24},
+		{3, 30, VargaParashari, 18},           // Mars in odd signs
+		{7, 30, VargaParashari, 10*30 + 12},   // Saturn in odd signs
+		{33, 30, VargaParashari, 1*30 + 18},   // Venus in even signs
+		{57.5, 30, VargaParashari, 7*30 + 15}, // Mars in even signs
+		{3, 30, VargaParivritti, 3 * 30},      // Equal trimsamsas
+		{359.999, 9, VargaParashari, 11*30 + 29.991},
+	}
+	for _, test := range tests {
+		if got := Varga(test.lon, test.division, test.scheme); math.Abs(got-test.expected) > 1e-6 {
+			t.Errorf("Varga(%.4f, D%d, %d) = %.6f, expected %.6f", test.lon, test.division, test.scheme, got, test.expected)
+		}
+	}
+}
+
+func TestVargaChart(t *testing.T) {
+	natal := testNatalChart(t, WithSidereal(SidmLahiri))
+
+	d9 := VargaChart(natal, 9, VargaParashari)
+	if d9.Division != 9 || natal.Division != 0 || d9.HouseSystem != 'W' {
+		t.Errorf("Unexpected divisional chart settings")
+	}
+	if d9.Angles[Asc] != Varga(natal.Angles[Asc], 9, VargaParashari) || d9.Angles[ARMC] != natal.Angles[ARMC] {
+		t.Error("Wrong navamsa angles")
+	}
+	lagna := int(d9.Angles[Asc] / 30)
+	for i, b := range d9.Bodies {
+		if want := Varga(natal.Bodies[i].Position.Lon, 9, VargaParashari); b.Position.Lon != want {
+			t.Errorf("%s at %.4f, expected %.4f", b.Name, b.Position.Lon, want)
+		}
+		if b.House != (b.Sign-lagna+12)%12+1 {
+			t.Errorf("%s in sign %d is in house %d with the Ascendant in sign %d", b.Name, b.Sign, b.House, lagna)
+		}
+	}
+	if natal.Bodies[0].Position.Lon == d9.Bodies[0].Position.Lon {
+		t.Error("Radix chart was modified")
+	}
+}
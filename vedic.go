@@ -0,0 +1,125 @@
+// Go Swiss Ephemeris - Vedic Astrology Helpers
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"errors"
+)
+
+// errNoBoundary is returned if a boundary was not crossed in the time an
+// interval of the Vedic calendar can last
+var errNoBoundary = errors.New("swisseph: boundary not found in search range")
+
+// Graha is one of the nine planets of Jyotish
+type Graha int
+
+const (
+	GrahaSun Graha = iota
+	GrahaMoon
+	GrahaMars
+	GrahaMercury
+	GrahaJupiter
+	GrahaVenus
+	GrahaSaturn
+	GrahaRahu // North node
+	GrahaKetu // South node
+)
+
+var grahaNames = [...]string{"Sun", "Moon", "Mars", "Mercury", "Jupiter", "Venus", "Saturn", "Rahu", "Ketu"}
+
+// String returns the name of the graha
+func (g Graha) String() string {
+	if g < 0 || int(g) >= len(grahaNames) {
+		return "unknown"
+	}
+	return grahaNames[g]
+}
+
+// Body returns the planet number of the graha. Rahu and Ketu are both the
+// mean node; Ketu lies opposite it.
+func (g Graha) Body() int32 {
+	switch g {
+	case GrahaSun:
+		return Sun
+	case GrahaMoon:
+		return Moon
+	case GrahaMars:
+		return Mars
+	case GrahaMercury:
+		return Mercury
+	case GrahaJupiter:
+		return Jupiter
+	case GrahaVenus:
+		return Venus
+	case GrahaSaturn:
+		return Saturn
+	}
+	return MeanNode
+}
+
+// signLords are the lords of the signs from Aries. Scorpio and Aquarius are
+// also ruled by Ketu and Rahu.
+var signLords = [12]Graha{
+	GrahaMars, GrahaVenus, GrahaMercury, GrahaMoon, GrahaSun, GrahaMercury,
+	GrahaVenus, GrahaMars, GrahaJupiter, GrahaSaturn, GrahaSaturn, GrahaJupiter,
+}
+
+// exaltationSigns are the signs of exaltation of the Sun to Saturn; each is
+// debilitated in the opposite sign
+var exaltationSigns = [7]int{0, 1, 9, 5, 3, 11, 6}
+
+// lockSidereal locks the calling goroutine to its OS thread, sets the
+// sidereal mode and returns a calculator for sidereal positions with speeds.
+// The returned function restores the previous settings and unlocks the thread.
+func lockSidereal(sidMode int32, flags int32) (*calculator, func()) {
+	unlock := lockThreadSettings()
+	SetSidMode(sidMode, 0, 0)
+
+	if ephemerisBits(flags) == 0 {
+		flags |= FlagSwieph
+	}
+	flags = (flags | FlagSidereal | FlagSpeed) &^ (FlagEquatorial | FlagXYZ | FlagRadians)
+	return &calculator{iflag: flags}, unlock
+}
+
+// grahaLon returns the longitude and speed of a graha at jd
+func (c *calculator) grahaLon(jd float64, g Graha) (float64, float64, error) {
+	lon, speed, err := c.lon(jd, g.Body())
+	if g == GrahaKetu {
+		lon = normDeg(lon + 180)
+	}
+	return lon, speed, err
+}
+
+// spanAround returns the times at which an increasing angle last crossed
+// start before jd and next crosses end after jd, searching span days to
+// either side
+func spanAround(jd, span, step float64, lonAt func(float64) (float64, float64, error), start, end float64) (float64, float64, error) {
+	before, err := findCrossings(jd-span, jd, step, lonAt, []float64{start})
+	if err != nil {
+		return 0, 0, err
+	}
+	after, err := findCrossings(jd, jd+span, step, lonAt, []float64{end})
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(before) == 0 || len(after) == 0 {
+		return 0, 0, errNoBoundary
+	}
+	return before[len(before)-1].jd, after[0].jd, nil
+}
@@ -0,0 +1,112 @@
+// Go Swiss Ephemeris - Void-of-Course Moon
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+// TraditionalPlanets returns the seven classical planets except the Moon
+func TraditionalPlanets() []int32 {
+	return []int32{Sun, Mercury, Venus, Mars, Jupiter, Saturn}
+}
+
+// ModernPlanets returns the traditional planets and Uranus, Neptune and Pluto
+func ModernPlanets() []int32 {
+	return append(TraditionalPlanets(), Uranus, Neptune, Pluto)
+}
+
+// VoidOfCourseOptions configures VoidOfCourseMoon
+type VoidOfCourseOptions struct {
+	Planets  []int32 // Aspected planets; defaults to TraditionalPlanets, or ModernPlanets if Modern is set
+	Modern   bool    // Include Uranus, Neptune and Pluto in the default planet set
+	Sidereal bool    // Use the sidereal zodiac set with SetSidMode for the signs
+	Flags    int32   // Ephemeris flag; defaults to FlagSwieph
+}
+
+// VoidOfCourse is a period in which the Moon makes no further major aspect
+// before leaving its sign
+type VoidOfCourse struct {
+	Start     float64 // Julian day (UT) of the last aspect, or of the previous ingress if there was none
+	End       float64 // Julian day (UT) of the Moon's ingress into the next sign
+	HasAspect bool    // False if the Moon made no aspect in the whole sign
+	Planet    int32   // Planet of the last aspect
+	Aspect    float64 // Angle of the last aspect
+	Sign      int     // Sign entered at End (0 = Aries)
+}
+
+// voidOfCourseLookback is longer than the Moon can stay in one sign, in days
+const voidOfCourseLookback = 3.5
+
+// VoidOfCourseMoon finds the void-of-course periods of the Moon that overlap
+// startJD to endJD (UT), in time order. A period starts with the last exact
+// Ptolemaic aspect (conjunction, sextile, square, trine or opposition) the
+// Moon makes to one of the planets while in a sign and ends with its ingress
+// into the next sign.
+//
+// If a calculation fell back to another ephemeris, the periods are returned
+// together with a warning error (see IsWarning).
+func VoidOfCourseMoon(startJD, endJD float64, opts VoidOfCourseOptions) ([]VoidOfCourse, error) {
+	if endJD <= startJD {
+		return nil, errInvalidRange
+	}
+
+	planets := opts.Planets
+	if len(planets) == 0 {
+		planets = TraditionalPlanets()
+		if opts.Modern {
+			planets = ModernPlanets()
+		}
+	}
+	flags := opts.Flags
+	if ephemerisBits(flags) == 0 {
+		flags |= FlagSwieph
+	}
+	if opts.Sidereal {
+		flags |= FlagSidereal
+	}
+
+	// The first ingress found starts the sign in which the Moon is at startJD
+	ingresses, warn := FindIngresses(startJD-voidOfCourseLookback, endJD+voidOfCourseLookback, Moon, flags, nil)
+	if warn != nil && !IsWarning(warn) {
+		return nil, warn
+	}
+
+	var periods []VoidOfCourse
+	for i := 1; i < len(ingresses); i++ {
+		prev, ing := ingresses[i-1], ingresses[i]
+		if ing.JD <= startJD {
+			continue
+		}
+
+		v := VoidOfCourse{Start: prev.JD, End: ing.JD, Sign: ing.To}
+		for _, pl := range planets {
+			events, err := FindAspects(prev.JD, ing.JD, BodyPoint(Moon), BodyPoint(pl), MajorAspects(), 0, flags)
+			if err != nil && !IsWarning(err) {
+				return nil, err
+			}
+			if n := len(events); n > 0 && events[n-1].JD > v.Start {
+				last := events[n-1]
+				v.Start, v.HasAspect, v.Planet, v.Aspect = last.JD, true, pl, last.Aspect
+			}
+		}
+
+		if v.Start >= endJD {
+			break
+		}
+		periods = append(periods, v)
+	}
+
+	return periods, warn
+}
@@ -0,0 +1,107 @@
+// Go Swiss Ephemeris - Void-of-Course Moon Tests
+//
+// Copyright (C) 2025-2026 Tejus Pratap <tejzpr@gmail.com>
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package swisseph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVoidOfCourseMoon(t *testing.T) {
+	start := Julday(2024, 1, 1, 0.0, GregCal)
+	end := Julday(2024, 1, 15, 0.0, GregCal)
+	opts := VoidOfCourseOptions{Modern: true, Flags: FlagMoseph}
+
+	periods, err := VoidOfCourseMoon(start, end, opts)
+	if err != nil {
+		t.Fatalf("VoidOfCourseMoon failed: %v", err)
+	}
+
+	// The Moon changes sign every 2.5 days or so
+	if len(periods) < 5 || len(periods) > 7 {
+		t.Fatalf("Expected about 6 periods in two weeks, got %d", len(periods))
+	}
+	if periods[0].End <= start || periods[len(periods)-1].Start >= end {
+		t.Error("Periods do not overlap the search range")
+	}
+
+	for i, v := range periods {
+		if !v.HasAspect || v.Start >= v.End || v.End-v.Start > 2.75 {
+			t.Errorf("Period %d has unexpected bounds: %+v", i, v)
+			continue
+		}
+
+		// The period ends with an ingress into the next sign
+		lon := CalcUT(v.End+1e-4, Moon, FlagMoseph).Data[0]
+		if int(lon/30) != v.Sign || math.Abs(angleDiff(lon, float64(v.Sign)*30)) > 0.01 {
+			t.Errorf("Period %d does not end at the Moon's ingress into sign %d", i, v.Sign)
+		}
+
+		// The period starts with an exact aspect
+		moon := CalcUT(v.Start, Moon, FlagMoseph).Data[0]
+		planet := CalcUT(v.Start, v.Planet, FlagMoseph).Data[0]
+		if sep := math.Abs(angleDiff(moon, planet)); math.Abs(sep-v.Aspect) > 1e-4 {
+			t.Errorf("Period %d starts at a separation of %.6f°, not %.0f°", i, sep, v.Aspect)
+		}
+
+		// No aspect to any planet follows within the period
+		for _, pl := range ModernPlanets() {
+			events, err := FindAspects(v.Start+1e-5, v.End, BodyPoint(Moon), BodyPoint(pl), MajorAspects(), 0, FlagMoseph)
+			if err != nil {
+				t.Fatalf("FindAspects failed: %v", err)
+			}
+			if len(events) > 0 {
+				t.Errorf("Period %d contains an aspect to planet %d at %.6f", i, pl, events[0].JD)
+			}
+		}
+	}
+}
+
+func TestVoidOfCourseMoon_PlanetSets(t *testing.T) {
+	start := Julday(2024, 3, 1, 0.0, GregCal)
+	end := Julday(2024, 4, 1, 0.0, GregCal)
+
+	traditional, err := VoidOfCourseMoon(start, end, VoidOfCourseOptions{Flags: FlagMoseph})
+	if err != nil {
+		t.Fatalf("VoidOfCourseMoon failed: %v", err)
+	}
+	modern, err := VoidOfCourseMoon(start, end, VoidOfCourseOptions{Modern: true, Flags: FlagMoseph})
+	if err != nil {
+		t.Fatalf("VoidOfCourseMoon failed: %v", err)
+	}
+
+	// More planets can only shorten the void periods; a period may drop out
+	// of the range at its end when it starts later
+	starts := make(map[float64]float64)
+	for _, v := range traditional {
+		starts[v.End] = v.Start
+	}
+	shorter := false
+	for i, v := range modern {
+		start, ok := starts[v.End]
+		if !ok || v.Start < start {
+			t.Errorf("Modern period %d %+v is not within a traditional period", i, v)
+		}
+		if v.Start > start {
+			shorter = true
+		}
+	}
+	if !shorter {
+		t.Error("Expected some modern periods to be shorter")
+	}
+}